@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/wazupwiddat/retrosheet/mysql"
+	"github.com/wazupwiddat/retrosheet/readers"
 )
 
 func main() {
@@ -29,6 +32,15 @@ func main() {
 		log.Fatal(err)
 	}
 
+	franchises := filepath.Join(filename, readers.FranchiseFile)
+	if _, err := os.Stat(franchises); err == nil {
+		mysql.LoadFranchises(franchises)
+	}
+	parks := filepath.Join(filename, readers.ParkFile)
+	if _, err := os.Stat(parks); err == nil {
+		mysql.LoadParks(parks)
+	}
+
 	g := runtime.NumCPU()
 	var wg sync.WaitGroup
 	wg.Add(g)
@@ -52,14 +64,18 @@ func main() {
 
 				mysql.LoadTeams(r)
 				mysql.LoadPlayers(r)
+				mysql.LoadRosters(r)
 				mysql.LoadGames(r)
+				mysql.LoadBoxScores(r)
 
-				// game events last
+				// game events, lineups and earned runs last
 				mysql.LoadGamesEvents(r)
 			}
 		}()
 	}
 
+	gameLogs := []string{}
+	schedules := []string{}
 	for _, f := range files {
 		if !f.Mode().IsRegular() || filepath.Ext(f.Name()) != ".zip" {
 			continue
@@ -69,9 +85,42 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		// game logs enrich the games from the event files, and schedules
+		// need the teams of both, so they wait until every event archive
+		// is loaded
+		if strings.HasPrefix(f.Name(), "gl") {
+			gameLogs = append(gameLogs, filename)
+			continue
+		}
+		if strings.HasSuffix(f.Name(), "sked.zip") {
+			schedules = append(schedules, filename)
+			continue
+		}
 		archiveChannel <- filename
 	}
 
 	close(archiveChannel)
 	wg.Wait()
+
+	for _, filename := range gameLogs {
+		r, err := zip.OpenReader(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		mysql.LoadGameLogs(r)
+		r.Close()
+	}
+	for _, filename := range schedules {
+		r, err := zip.OpenReader(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		mysql.LoadSchedules(r)
+		r.Close()
+	}
+
+	biographies := filepath.Join(filename, readers.BioFile)
+	if _, err := os.Stat(biographies); err == nil {
+		mysql.LoadBiographies(biographies)
+	}
 }
@@ -43,16 +43,24 @@ func main() {
 					break
 				}
 
-				downloadYear(year, outputDirectory)
+				// box score event files, game logs and schedules cover
+				// the years without event files too
+				if validYear(year) {
+					downloadYear(year, outputDirectory)
+				}
+				downloadBoxScores(year, outputDirectory)
+				downloadGameLogs(year, outputDirectory)
+				downloadSchedule(year, outputDirectory)
 				fmt.Printf("Download Complete(%d)...\n", year)
 			}
 		}()
 	}
 
+	downloadFranchises(outputDirectory)
+	downloadBiographies(outputDirectory)
+	downloadParks(outputDirectory)
+
 	for y := startYear; y <= endYear; y++ {
-		if ok := validYear(y); !ok {
-			continue
-		}
 		wch <- y
 	}
 	close(wch)
@@ -70,21 +78,66 @@ func validYear(year int) bool {
 
 func downloadYear(year int, dir string) {
 	filename := fmt.Sprintf("%deve.zip", year)
-	filePath := filepath.Join(dir, filename)
-	outputFile, err := os.Create(filePath)
+	url := fmt.Sprintf("http://www.retrosheet.org/events/%deve.zip", year)
+	download(url, filepath.Join(dir, filename))
+}
+
+// downloadBoxScores fetches the box score event files of the year, which
+// Retrosheet only has for the games without play by play.
+func downloadBoxScores(year int, dir string) {
+	filename := fmt.Sprintf("%deb.zip", year)
+	url := fmt.Sprintf("http://www.retrosheet.org/events/%deb.zip", year)
+	download(url, filepath.Join(dir, filename))
+}
+
+func downloadGameLogs(year int, dir string) {
+	filename := fmt.Sprintf("gl%d.zip", year)
+	url := fmt.Sprintf("http://www.retrosheet.org/gamelogs/gl%d.zip", year)
+	download(url, filepath.Join(dir, filename))
+}
+
+func downloadSchedule(year int, dir string) {
+	filename := fmt.Sprintf("%dsked.zip", year)
+	url := fmt.Sprintf("http://www.retrosheet.org/schedule/%dSKED.ZIP", year)
+	download(url, filepath.Join(dir, filename))
+}
+
+// downloadFranchises fetches the team abbreviation by franchise file, which
+// covers every year.
+func downloadFranchises(dir string) {
+	download("http://www.retrosheet.org/CurrentNames.csv", filepath.Join(dir, "CurrentNames.csv"))
+}
+
+// downloadBiographies fetches the biofile, which also covers every year.
+func downloadBiographies(dir string) {
+	download("http://www.retrosheet.org/BIOFILE.TXT", filepath.Join(dir, "BIOFILE.TXT"))
+}
+
+// downloadParks fetches the parks file, which also covers every year.
+func downloadParks(dir string) {
+	download("http://www.retrosheet.org/parkcode.txt", filepath.Join(dir, "parkcode.txt"))
+}
+
+// download saves url to filePath. Nothing is saved when Retrosheet does not
+// have the file, such as box scores for a year that is all play by play.
+func download(url string, filePath string) {
+	resp, err := http.Get(url)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	defer outputFile.Close()
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		fmt.Printf("%s: %s\n", url, resp.Status)
+		return
+	}
 
-	url := fmt.Sprintf("http://www.retrosheet.org/events/%deve.zip", year)
-	resp, err := http.Get(url)
+	outputFile, err := os.Create(filePath)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	defer resp.Body.Close()
+	defer outputFile.Close()
 
 	_, err = io.Copy(outputFile, resp.Body)
 	if err != nil {
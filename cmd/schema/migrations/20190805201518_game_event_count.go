@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upGameEventCount, downGameEventCount)
+}
+
+func upGameEventCount(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `game_events` " +
+			"ADD COLUMN `balls` tinyint(3) NOT NULL DEFAULT -1 AFTER `inning_half`," +
+			"ADD COLUMN `strikes` tinyint(3) NOT NULL DEFAULT -1 AFTER `balls`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downGameEventCount(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `game_events` " +
+			"DROP COLUMN `balls`," +
+			"DROP COLUMN `strikes`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
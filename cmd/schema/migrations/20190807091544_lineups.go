@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upLineups, downLineups)
+}
+
+func upLineups(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `lineups` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`player_id` int(11) NOT NULL," +
+			"`side` tinyint(3) NOT NULL," +
+			"`batting_order` tinyint(3) NOT NULL," +
+			"`position` tinyint(3) NOT NULL," +
+			"`entry_event` int(11) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `game_id` (`game_id`,`entry_event`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downLineups(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `lineups`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upEarnedRuns, downEarnedRuns)
+}
+
+func upEarnedRuns(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `earned_runs` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`player_id` int(11) NOT NULL," +
+			"`runs` tinyint(3) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"UNIQUE KEY `game_player` (`game_id`,`player_id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downEarnedRuns(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `earned_runs`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upComments, downComments)
+}
+
+func upComments(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"ADD COLUMN `comments` TEXT NOT NULL AFTER `home`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downComments(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"DROP COLUMN `comments`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
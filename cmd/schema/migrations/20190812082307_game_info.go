@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upGameInfo, downGameInfo)
+}
+
+func upGameInfo(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"ADD COLUMN `site` varchar(5) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `game_number` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `start_time` varchar(8) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `day_night` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `use_dh` tinyint(1) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `ump_home` varchar(8) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `ump_1b` varchar(8) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `ump_2b` varchar(8) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `ump_3b` varchar(8) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `attendance` int(11) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `temperature` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `wind_direction` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `wind_speed` tinyint(3) NOT NULL DEFAULT -1," +
+			"ADD COLUMN `field_condition` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `precipitation` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `sky` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `time_of_game` int(11) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `winning_pitcher` varchar(10) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `losing_pitcher` varchar(10) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `save_pitcher` varchar(10) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `how_scored` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `pitches` tinyint(3) NOT NULL DEFAULT 0;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downGameInfo(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"DROP COLUMN `site`," +
+			"DROP COLUMN `game_number`," +
+			"DROP COLUMN `start_time`," +
+			"DROP COLUMN `day_night`," +
+			"DROP COLUMN `use_dh`," +
+			"DROP COLUMN `ump_home`," +
+			"DROP COLUMN `ump_1b`," +
+			"DROP COLUMN `ump_2b`," +
+			"DROP COLUMN `ump_3b`," +
+			"DROP COLUMN `attendance`," +
+			"DROP COLUMN `temperature`," +
+			"DROP COLUMN `wind_direction`," +
+			"DROP COLUMN `wind_speed`," +
+			"DROP COLUMN `field_condition`," +
+			"DROP COLUMN `precipitation`," +
+			"DROP COLUMN `sky`," +
+			"DROP COLUMN `time_of_game`," +
+			"DROP COLUMN `winning_pitcher`," +
+			"DROP COLUMN `losing_pitcher`," +
+			"DROP COLUMN `save_pitcher`," +
+			"DROP COLUMN `how_scored`," +
+			"DROP COLUMN `pitches`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upGameEventSequence, downGameEventSequence)
+}
+
+func upGameEventSequence(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `game_events` " +
+			"ADD COLUMN `sequence` int(11) NOT NULL DEFAULT 0 AFTER `game_id`," +
+			"ADD COLUMN `record` TEXT NOT NULL AFTER `event_detail`," +
+			"ADD KEY `game_sequence` (`game_id`,`sequence`);",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downGameEventSequence(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `game_events` " +
+			"DROP KEY `game_sequence`," +
+			"DROP COLUMN `sequence`," +
+			"DROP COLUMN `record`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
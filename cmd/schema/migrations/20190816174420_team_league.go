@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upTeamLeague, downTeamLeague)
+}
+
+func upTeamLeague(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `teams` " +
+			"MODIFY COLUMN `league` tinyint(3) NOT NULL;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downTeamLeague(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `teams` " +
+			"MODIFY COLUMN `league` tinyint(1) NOT NULL;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upFranchises, downFranchises)
+}
+
+func upFranchises(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `franchises` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`franchise_id` varchar(4) NOT NULL," +
+			"`team_code` varchar(4) NOT NULL," +
+			"`league` tinyint(3) NOT NULL," +
+			"`division` varchar(2) NOT NULL DEFAULT ''," +
+			"`location` varchar(30) NOT NULL DEFAULT ''," +
+			"`nickname` varchar(30) NOT NULL DEFAULT ''," +
+			"`alt_nickname` varchar(30) NOT NULL DEFAULT ''," +
+			"`first_game` datetime NOT NULL," +
+			"`last_game` datetime DEFAULT NULL," +
+			"`city` varchar(30) NOT NULL DEFAULT ''," +
+			"`state` varchar(4) NOT NULL DEFAULT ''," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `franchise_id` (`franchise_id`)," +
+			"KEY `team_code` (`team_code`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downFranchises(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `franchises`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
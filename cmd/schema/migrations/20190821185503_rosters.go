@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upRosters, downRosters)
+}
+
+func upRosters(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `rosters` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`player_id` int(11) NOT NULL," +
+			"`team_id` int(11) NOT NULL," +
+			"`year` int(11) NOT NULL," +
+			"`bats` tinyint(3) NOT NULL," +
+			"`throws` tinyint(3) NOT NULL," +
+			"`position` varchar(3) NOT NULL DEFAULT ''," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `team_id` (`team_id`)," +
+			"KEY `player_year` (`player_id`,`year`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downRosters(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `rosters`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upBoxScores, downBoxScores)
+}
+
+func upBoxScores(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `batting_lines` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`player_id` int(11) NOT NULL," +
+			"`side` tinyint(3) NOT NULL," +
+			"`batting_order` tinyint(3) NOT NULL," +
+			"`sequence` tinyint(3) NOT NULL," +
+			"`ab` smallint(6) NOT NULL," +
+			"`r` smallint(6) NOT NULL," +
+			"`h` smallint(6) NOT NULL," +
+			"`doubles` smallint(6) NOT NULL," +
+			"`triples` smallint(6) NOT NULL," +
+			"`hr` smallint(6) NOT NULL," +
+			"`rbi` smallint(6) NOT NULL," +
+			"`sh` smallint(6) NOT NULL," +
+			"`sf` smallint(6) NOT NULL," +
+			"`hbp` smallint(6) NOT NULL," +
+			"`bb` smallint(6) NOT NULL," +
+			"`ibb` smallint(6) NOT NULL," +
+			"`k` smallint(6) NOT NULL," +
+			"`sb` smallint(6) NOT NULL," +
+			"`cs` smallint(6) NOT NULL," +
+			"`gidp` smallint(6) NOT NULL," +
+			"`interference` smallint(6) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `game_id` (`game_id`)," +
+			"KEY `player_id` (`player_id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec(
+		"CREATE TABLE `pitching_lines` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`player_id` int(11) NOT NULL," +
+			"`side` tinyint(3) NOT NULL," +
+			"`sequence` tinyint(3) NOT NULL," +
+			"`outs` smallint(6) NOT NULL," +
+			"`no_outs` smallint(6) NOT NULL," +
+			"`bfp` smallint(6) NOT NULL," +
+			"`h` smallint(6) NOT NULL," +
+			"`doubles` smallint(6) NOT NULL," +
+			"`triples` smallint(6) NOT NULL," +
+			"`hr` smallint(6) NOT NULL," +
+			"`r` smallint(6) NOT NULL," +
+			"`er` smallint(6) NOT NULL," +
+			"`bb` smallint(6) NOT NULL," +
+			"`ibb` smallint(6) NOT NULL," +
+			"`k` smallint(6) NOT NULL," +
+			"`hbp` smallint(6) NOT NULL," +
+			"`wp` smallint(6) NOT NULL," +
+			"`bk` smallint(6) NOT NULL," +
+			"`sh` smallint(6) NOT NULL," +
+			"`sf` smallint(6) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `game_id` (`game_id`)," +
+			"KEY `player_id` (`player_id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec(
+		"CREATE TABLE `fielding_lines` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`player_id` int(11) NOT NULL," +
+			"`side` tinyint(3) NOT NULL," +
+			"`sequence` tinyint(3) NOT NULL," +
+			"`position` tinyint(3) NOT NULL," +
+			"`outs` smallint(6) NOT NULL," +
+			"`po` smallint(6) NOT NULL," +
+			"`a` smallint(6) NOT NULL," +
+			"`e` smallint(6) NOT NULL," +
+			"`dp` smallint(6) NOT NULL," +
+			"`tp` smallint(6) NOT NULL," +
+			"`pb` smallint(6) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `game_id` (`game_id`)," +
+			"KEY `player_id` (`player_id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec(
+		"CREATE TABLE `line_scores` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`game_id` int(11) NOT NULL," +
+			"`side` tinyint(3) NOT NULL," +
+			"`inning` tinyint(3) NOT NULL," +
+			"`runs` tinyint(3) NOT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `game_id` (`game_id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downBoxScores(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `batting_lines`")
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec("DROP TABLE `pitching_lines`")
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec("DROP TABLE `fielding_lines`")
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec("DROP TABLE `line_scores`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
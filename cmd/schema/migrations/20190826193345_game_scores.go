@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upGameScores, downGameScores)
+}
+
+func upGameScores(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"ADD COLUMN `visitor_score` smallint(6) NOT NULL DEFAULT -1 AFTER `home`," +
+			"ADD COLUMN `home_score` smallint(6) NOT NULL DEFAULT -1 AFTER `visitor_score`," +
+			"ADD COLUMN `outs` smallint(6) NOT NULL DEFAULT -1 AFTER `home_score`," +
+			"ADD KEY `game_id` (`game_id`);",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downGameScores(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"DROP KEY `game_id`," +
+			"DROP COLUMN `visitor_score`," +
+			"DROP COLUMN `home_score`," +
+			"DROP COLUMN `outs`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
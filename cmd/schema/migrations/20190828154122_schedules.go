@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upSchedules, downSchedules)
+}
+
+func upSchedules(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `schedules` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`scheduled` date NOT NULL," +
+			"`game_number` tinyint(3) NOT NULL," +
+			"`day_of_week` varchar(3) NOT NULL," +
+			"`visitor` int(11) NOT NULL," +
+			"`visitor_game_number` smallint(6) NOT NULL," +
+			"`home` int(11) NOT NULL," +
+			"`home_game_number` smallint(6) NOT NULL," +
+			"`day_night` tinyint(3) NOT NULL," +
+			"`postponement` varchar(255) NOT NULL," +
+			"`makeup_info` varchar(255) NOT NULL," +
+			"`makeup` date DEFAULT NULL," +
+			"PRIMARY KEY (`id`)," +
+			"KEY `home_scheduled` (`home`,`scheduled`,`game_number`)," +
+			"KEY `visitor` (`visitor`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downSchedules(txn *sql.Tx) error {
+	_, err := txn.Exec("DROP TABLE `schedules`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
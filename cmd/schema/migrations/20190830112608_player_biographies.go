@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upPlayerBiographies, downPlayerBiographies)
+}
+
+func upPlayerBiographies(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `players` " +
+			"ADD COLUMN `nickname` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `birth_date` date DEFAULT NULL," +
+			"ADD COLUMN `birth_city` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `birth_state` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `birth_country` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `debut` date DEFAULT NULL," +
+			"ADD COLUMN `last_game` date DEFAULT NULL," +
+			"ADD COLUMN `death_date` date DEFAULT NULL," +
+			"ADD COLUMN `death_city` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `death_state` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `death_country` varchar(60) NOT NULL DEFAULT ''," +
+			"ADD COLUMN `height` tinyint(3) NOT NULL DEFAULT 0," +
+			"ADD COLUMN `weight` smallint(6) NOT NULL DEFAULT 0;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downPlayerBiographies(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `players` " +
+			"DROP COLUMN `nickname`," +
+			"DROP COLUMN `birth_date`," +
+			"DROP COLUMN `birth_city`," +
+			"DROP COLUMN `birth_state`," +
+			"DROP COLUMN `birth_country`," +
+			"DROP COLUMN `debut`," +
+			"DROP COLUMN `last_game`," +
+			"DROP COLUMN `death_date`," +
+			"DROP COLUMN `death_city`," +
+			"DROP COLUMN `death_state`," +
+			"DROP COLUMN `death_country`," +
+			"DROP COLUMN `height`," +
+			"DROP COLUMN `weight`;",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose"
+)
+
+func init() {
+	goose.AddMigration(upParks, downParks)
+}
+
+func upParks(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"CREATE TABLE `parks` (" +
+			"`id` int(11) NOT NULL AUTO_INCREMENT," +
+			"`park_id` varchar(5) NOT NULL UNIQUE," +
+			"`name` varchar(60) NOT NULL," +
+			"`aka` varchar(255) NOT NULL," +
+			"`city` varchar(40) NOT NULL," +
+			"`state` varchar(40) NOT NULL," +
+			"`start` date DEFAULT NULL," +
+			"`end` date DEFAULT NULL," +
+			"`league` tinyint(3) NOT NULL," +
+			"`notes` varchar(255) NOT NULL," +
+			"PRIMARY KEY (`id`)" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8;",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec(
+		"ALTER TABLE `games` " +
+			"ADD COLUMN `park_id` int(11) DEFAULT NULL AFTER `outs`," +
+			"ADD CONSTRAINT `games_park` FOREIGN KEY (`park_id`) REFERENCES `parks` (`id`);",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downParks(txn *sql.Tx) error {
+	_, err := txn.Exec(
+		"ALTER TABLE `games` " +
+			"DROP FOREIGN KEY `games_park`," +
+			"DROP COLUMN `park_id`;",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = txn.Exec("DROP TABLE `parks`")
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package models
+
+// Adjustment is a badj, padj, ladj, radj or presadj record, stored on the
+// plays it applies to. Which fields are set depends on Type:
+//
+//	BatterAdj                 Player bats Hand, e.g. a switch hitter batting
+//	                          from their unusual side
+//	PitcherAdj                Player throws Hand
+//	LineupAdj                 Side bats out of order, with BattingOrder up
+//	RunnerAdj                 Player is placed on Base, as with the runner
+//	                          on second in extra innings
+//	PitcherResponsibilityAdj  the runner on Base is charged to Player
+type Adjustment struct {
+	Type         EventType
+	Player       int      `json:",omitempty"`
+	Hand         Handed   `json:",omitempty"`
+	Side         TeamSide `json:",omitempty"`
+	BattingOrder int      `json:",omitempty"`
+	Base         int      `json:",omitempty"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import "github.com/gocraft/dbr"
+
+type TeamSide int
+
+const (
+	VisitingSide TeamSide = 0
+	HomeSide     TeamSide = 1
+)
+
+func (s TeamSide) String() string {
+	names := [...]string{
+		"Visiting",
+		"Home",
+	}
+	if s < VisitingSide || s > HomeSide {
+		return "Invalid side"
+	}
+	return names[s]
+}
+
+// Appearance is a player entering a game, either in the starting lineup or
+// as a substitute. BattingOrder is 0 for a pitcher who does not bat because
+// of the designated hitter. EntryEvent is the sequence of the start or sub
+// record in game_events, so the player is in the game from the events after
+// it on.
+type Appearance struct {
+	ID           int
+	GameID       int      `db:"game_id"`
+	Player       int      `db:"player_id"`
+	Side         TeamSide `db:"side"`
+	BattingOrder int      `db:"batting_order"`
+	Position     Position `db:"position"`
+	EntryEvent   int      `db:"entry_event"`
+}
+
+func (a *Appearance) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("lineups").
+		Columns("game_id", "player_id", "side", "batting_order", "position", "entry_event").
+		Record(a).
+		Exec()
+	return err
+}
+
+func SaveAppearances(session dbr.SessionRunner, appearances []Appearance) error {
+	var err error
+	for _, a := range appearances {
+		err = a.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+// GetAppearances returns the lineup of a game, including substitutes, in the
+// order the players entered.
+func GetAppearances(session dbr.SessionRunner, gameID int) ([]Appearance, error) {
+	appearances := []Appearance{}
+	_, err := session.Select("*").From("lineups").
+		Where("lineups.game_id=?", gameID).
+		OrderBy("lineups.entry_event").
+		Load(&appearances)
+	return appearances, err
+}
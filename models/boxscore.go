@@ -0,0 +1,155 @@
+package models
+
+import "github.com/gocraft/dbr"
+
+// BattingLine is a player's batting in a box score game, from a stat,bline
+// record. Retrosheet uses -1 for values that were not recorded.
+type BattingLine struct {
+	ID           int
+	GameID       int      `db:"game_id"`
+	Player       int      `db:"player_id"`
+	Side         TeamSide `db:"side"`
+	BattingOrder int      `db:"batting_order"`
+	Sequence     int      `db:"sequence"`
+	AtBats       int      `db:"ab"`
+	Runs         int      `db:"r"`
+	Hits         int      `db:"h"`
+	Doubles      int      `db:"doubles"`
+	Triples      int      `db:"triples"`
+	HomeRuns     int      `db:"hr"`
+	RBI          int      `db:"rbi"`
+	SacHits      int      `db:"sh"`
+	SacFlies     int      `db:"sf"`
+	HitByPitch   int      `db:"hbp"`
+	Walks        int      `db:"bb"`
+	IntWalks     int      `db:"ibb"`
+	StrikeOuts   int      `db:"k"`
+	StolenBases  int      `db:"sb"`
+	CaughtSteal  int      `db:"cs"`
+	GIDP         int      `db:"gidp"`
+	Interference int      `db:"interference"`
+}
+
+// PitchingLine is a pitcher's line in a box score game, from a stat,pline
+// record. Outs is the innings pitched times three, and NoOuts the batters
+// faced in an inning the pitcher left without getting an out.
+type PitchingLine struct {
+	ID           int
+	GameID       int      `db:"game_id"`
+	Player       int      `db:"player_id"`
+	Side         TeamSide `db:"side"`
+	Sequence     int      `db:"sequence"`
+	Outs         int      `db:"outs"`
+	NoOuts       int      `db:"no_outs"`
+	BattersFaced int      `db:"bfp"`
+	Hits         int      `db:"h"`
+	Doubles      int      `db:"doubles"`
+	Triples      int      `db:"triples"`
+	HomeRuns     int      `db:"hr"`
+	Runs         int      `db:"r"`
+	EarnedRuns   int      `db:"er"`
+	Walks        int      `db:"bb"`
+	IntWalks     int      `db:"ibb"`
+	StrikeOuts   int      `db:"k"`
+	HitByPitch   int      `db:"hbp"`
+	WildPitches  int      `db:"wp"`
+	Balks        int      `db:"bk"`
+	SacHits      int      `db:"sh"`
+	SacFlies     int      `db:"sf"`
+}
+
+// FieldingLine is a player's fielding at one position in a box score game,
+// from a stat,dline record. Outs is the innings played times three.
+type FieldingLine struct {
+	ID          int
+	GameID      int      `db:"game_id"`
+	Player      int      `db:"player_id"`
+	Side        TeamSide `db:"side"`
+	Sequence    int      `db:"sequence"`
+	Position    Position `db:"position"`
+	Outs        int      `db:"outs"`
+	Putouts     int      `db:"po"`
+	Assists     int      `db:"a"`
+	Errors      int      `db:"e"`
+	DoublePlays int      `db:"dp"`
+	TriplePlays int      `db:"tp"`
+	PassedBalls int      `db:"pb"`
+}
+
+// LineScore is the runs a team scored in one inning of a box score game.
+type LineScore struct {
+	ID     int
+	GameID int      `db:"game_id"`
+	Side   TeamSide `db:"side"`
+	Inning int
+	Runs   int
+}
+
+// BoxScore collects the lines read from box score event files.
+type BoxScore struct {
+	Batting    []BattingLine
+	Pitching   []PitchingLine
+	Fielding   []FieldingLine
+	LineScores []LineScore
+}
+
+func (b *BattingLine) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("batting_lines").
+		Columns("game_id", "player_id", "side", "batting_order", "sequence",
+			"ab", "r", "h", "doubles", "triples", "hr", "rbi", "sh", "sf", "hbp", "bb",
+			"ibb", "k", "sb", "cs", "gidp", "interference").
+		Record(b).
+		Exec()
+	return err
+}
+
+func (p *PitchingLine) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("pitching_lines").
+		Columns("game_id", "player_id", "side", "sequence", "outs", "no_outs",
+			"bfp", "h", "doubles", "triples", "hr", "r", "er", "bb", "ibb", "k", "hbp",
+			"wp", "bk", "sh", "sf").
+		Record(p).
+		Exec()
+	return err
+}
+
+func (f *FieldingLine) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("fielding_lines").
+		Columns("game_id", "player_id", "side", "sequence", "position", "outs",
+			"po", "a", "e", "dp", "tp", "pb").
+		Record(f).
+		Exec()
+	return err
+}
+
+func (l *LineScore) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("line_scores").
+		Columns("game_id", "side", "inning", "runs").
+		Record(l).
+		Exec()
+	return err
+}
+
+func SaveBoxScore(session dbr.SessionRunner, box BoxScore) error {
+	for _, b := range box.Batting {
+		if err := b.Save(session); err != nil {
+			return err
+		}
+	}
+	for _, p := range box.Pitching {
+		if err := p.Save(session); err != nil {
+			return err
+		}
+	}
+	for _, f := range box.Fielding {
+		if err := f.Save(session); err != nil {
+			return err
+		}
+	}
+	for _, l := range box.LineScores {
+		if err := l.Save(session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
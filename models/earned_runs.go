@@ -0,0 +1,38 @@
+package models
+
+import "github.com/gocraft/dbr"
+
+// EarnedRuns is the number of earned runs charged to a pitcher in a game,
+// from the data,er records at the end of each game in an event file.
+type EarnedRuns struct {
+	ID     int
+	GameID int `db:"game_id"`
+	Player int `db:"player_id"`
+	Runs   int `db:"runs"`
+}
+
+func (er *EarnedRuns) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("earned_runs").
+		Columns("game_id", "player_id", "runs").
+		Record(er).
+		Exec()
+	return err
+}
+
+func SaveEarnedRuns(session dbr.SessionRunner, earnedRuns []EarnedRuns) error {
+	var err error
+	for _, er := range earnedRuns {
+		err = er.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+func GetEarnedRuns(session dbr.SessionRunner, gameID int) ([]EarnedRuns, error) {
+	earnedRuns := []EarnedRuns{}
+	_, err := session.Select("*").From("earned_runs").
+		Where("earned_runs.game_id=?", gameID).Load(&earnedRuns)
+	return earnedRuns, err
+}
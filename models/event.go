@@ -21,6 +21,10 @@ const (
 	BatterAdj  EventType = 8
 	LineupAdj  EventType = 9
 	PitcherAdj EventType = 10
+	RunnerAdj  EventType = 11
+	// PitcherResponsibilityAdj is a presadj record, which charges a
+	// runner to a pitcher other than the one on the mound.
+	PitcherResponsibilityAdj EventType = 12
 )
 
 type InfoType int
@@ -30,10 +34,34 @@ const (
 	VisitingTeam  InfoType = 1
 	HomeTeam      InfoType = 2
 	GameDate      InfoType = 3
-
-	// Add other Info types if needed
+	Site          InfoType = 4
+	GameNumber    InfoType = 5
+	StartTime     InfoType = 6
+	DayOrNight    InfoType = 7
+	UsedDH        InfoType = 8
+	UmpireHome    InfoType = 9
+	UmpireFirst   InfoType = 10
+	UmpireSecond  InfoType = 11
+	UmpireThird   InfoType = 12
+	Attendance    InfoType = 13
+	Temperature   InfoType = 14
+	WindDir       InfoType = 15
+	WindSpeed     InfoType = 16
+	FieldCond     InfoType = 17
+	Precip        InfoType = 18
+	Sky           InfoType = 19
+	TimeOfGame    InfoType = 20
+	WinningPitch  InfoType = 21
+	LosingPitch   InfoType = 22
+	SavePitch     InfoType = 23
+	HowScored     InfoType = 24
+	PitchesInfo   InfoType = 25
 )
 
+// UnknownCount is stored in Balls and Strikes when the count was not
+// recorded (?? in older seasons).
+const UnknownCount = -1
+
 type InningHalf int
 
 const (
@@ -164,6 +192,8 @@ const (
 	ModifierAppealPlay
 	ModifierPopupBunt
 	ModifierForceOut
+	// ModifierHitLocation is a bare hit location such as the 78 in S8/78.
+	ModifierHitLocation
 )
 
 func (pm PlayModifier) String() string {
@@ -210,16 +240,15 @@ func (pm PlayModifier) String() string {
 		"Appeal play",
 		"Popup bunt",
 		"Forced out",
+		"Hit location",
 	}
 
-	if pm < ModifierFlyBall || pm > ModifierForceOut {
+	if pm < ModifierFlyBall || pm > ModifierHitLocation {
 		return "Invalid play modifier"
 	}
 	return names[pm]
 }
 
-type HitLocation string
-
 type Modifier struct {
 	PlayModifier
 	Location HitLocation
@@ -232,12 +261,62 @@ func (m Modifier) String() string {
 type RunnerAdvance struct {
 	StartBase  int
 	FinishBase int
+	// Out is set when the runner was put out trying to reach FinishBase.
+	Out bool `json:",omitempty"`
+	// Fielders is the fielding sequence given in parentheses, e.g. (52).
+	Fielders []Position `json:",omitempty"`
+	// Errors lists the fielders charged with an error on the advance.
+	Errors []Position `json:",omitempty"`
+	// ErrorNegatedOut is set for an X advance where an error let the
+	// runner reach safely, e.g. 1X3(6E5).
+	ErrorNegatedOut bool `json:",omitempty"`
+	Unearned        bool `json:",omitempty"` // (UR)
+	TeamUnearned    bool `json:",omitempty"` // (TUR)
+	NoRBI           bool `json:",omitempty"` // (NR) or (NORBI)
+	RBI             bool `json:",omitempty"` // (RBI)
+	// Implied is set on advances Retrosheet leaves out of the play, such
+	// as the batter reaching first on a single or a runner forced to
+	// second on a walk.
+	Implied bool `json:",omitempty"`
 }
 
 func (ra RunnerAdvance) String() string {
+	if ra.Out {
+		return fmt.Sprintf("%d out at %d", ra.StartBase, ra.FinishBase)
+	}
 	return fmt.Sprintf("%d to %d", ra.StartBase, ra.FinishBase)
 }
 
+type CreditType int
+
+const (
+	CreditPutout CreditType = iota
+	CreditAssist
+	CreditError
+)
+
+func (ct CreditType) String() string {
+	names := [...]string{
+		"Putout",
+		"Assist",
+		"Error",
+	}
+	if ct < CreditPutout || ct > CreditError {
+		return "Invalid credit type"
+	}
+	return names[ct]
+}
+
+// FieldingCredit is a putout, assist or error charged to a fielder.
+type FieldingCredit struct {
+	Position Position
+	Credit   CreditType
+}
+
+func (fc FieldingCredit) String() string {
+	return fmt.Sprintf("%s %s", fc.Credit, fc.Position)
+}
+
 func NewGameEvent(gameID int, et EventType, inning int, half InningHalf, player int) GameEvent {
 	ge := GameEvent{
 		GameID:     gameID,
@@ -250,25 +329,33 @@ func NewGameEvent(gameID int, et EventType, inning int, half InningHalf, player
 }
 
 func (ed EventDetail) String() string {
-	return fmt.Sprintf("\nPlay: %s\n\tFielders: %s\n\tModifiers: %s\n\tRunners: %s\n",
-		ed.Play, ed.Fielders, ed.Modifiers, ed.RunnerAdv)
+	return fmt.Sprintf("\nPlay: %s\n\tFielders: %s\n\tCredits: %s\n\tModifiers: %s\n\tRunners: %s\n",
+		ed.Play, ed.Fielders, ed.Credits, ed.Modifiers, ed.RunnerAdv)
 }
 
 type GameEvent struct {
-	ID         int         `db:"id" json:"-"`
-	GameID     int         `db:"game_id" json:"-"`
-	Event      EventType   `db:"event" json:"-"`
-	Inning     int         `db:"inning" json:"-"`
-	InningHalf InningHalf  `db:"inning_half" json:"-"`
-	Player     int         `db:"player_id" json:"-"`
-	Play       EventDetail `db:"-" json:"event_detail"`
-	PlayJSON   string      `db:"event_detail" json:"-"`
+	ID          int          `db:"id" json:"-"`
+	GameID      int          `db:"game_id" json:"-"`
+	Event       EventType    `db:"event" json:"-"`
+	Inning      int          `db:"inning" json:"-"`
+	InningHalf  InningHalf   `db:"inning_half" json:"-"`
+	Sequence    int          `db:"sequence" json:"-"`
+	Player      int          `db:"player_id" json:"-"`
+	Balls       int          `db:"balls" json:"-"`
+	Strikes     int          `db:"strikes" json:"-"`
+	Play        EventDetail  `db:"-" json:"event_detail"`
+	Pitches     []Pitch      `db:"-" json:"pitches,omitempty"`
+	Comments    []string     `db:"-" json:"comments,omitempty"`
+	Adjustments []Adjustment `db:"-" json:"adjustments,omitempty"`
+	PlayJSON    string       `db:"event_detail" json:"-"`
+	Record      string       `db:"record" json:"-"`
 }
 
 type EventDetail struct {
 	Play       BasicPlay
 	ExtraPlays []BasicPlay
 	Fielders   []Position
+	Credits    []FieldingCredit `json:",omitempty"`
 	Modifiers  []Modifier
 	RunnerAdv  []RunnerAdvance
 }
@@ -289,7 +376,7 @@ func (g *GameEvent) marshalEventDetail() error {
 func (e *GameEvent) Save(session dbr.SessionRunner) error {
 	e.marshalEventDetail()
 	_, err := session.InsertInto("game_events").
-		Columns("game_id", "player_id", "event", "inning", "inning_half", "event_detail").
+		Columns("game_id", "sequence", "player_id", "event", "inning", "inning_half", "balls", "strikes", "event_detail", "record").
 		Record(e).
 		Exec()
 	return err
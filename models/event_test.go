@@ -35,7 +35,11 @@ func TestEventSave(t *testing.T) {
 			Modifiers: []models.Modifier{
 				{
 					PlayModifier: models.ModifierLinedDrive,
-					Location:     "9LS",
+					Location: models.HitLocation{
+						Zone:      []models.Position{models.PositionRightField},
+						Direction: models.DirectionLeft,
+						Depth:     models.DepthShallow,
+					},
 				},
 			},
 			RunnerAdv: []models.RunnerAdvance{
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocraft/dbr"
+)
+
+// Franchise is one period of a franchise's history: the team code, league,
+// location and nickname it used between FirstGame and LastGame. LastGame is
+// not valid for the current period. All the periods of a franchise share
+// FranchiseID, e.g. LAA, CAL and ANA are all the ANA franchise.
+type Franchise struct {
+	ID          int
+	FranchiseID string `db:"franchise_id"`
+	TeamCode    string `db:"team_code"`
+	League      League
+	Division    string
+	Location    string
+	Nickname    string
+	AltNickname string       `db:"alt_nickname"`
+	FirstGame   time.Time    `db:"first_game"`
+	LastGame    dbr.NullTime `db:"last_game"`
+	City        string
+	State       string
+}
+
+func (f *Franchise) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("franchises").
+		Columns("franchise_id", "team_code", "league", "division", "location",
+			"nickname", "alt_nickname", "first_game", "last_game", "city", "state").
+		Record(f).
+		Exec()
+	return err
+}
+
+func SaveFranchises(session dbr.SessionRunner, franchises []Franchise) error {
+	var err error
+	for _, f := range franchises {
+		err = f.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+// GetFranchise returns the history of a franchise, oldest first.
+func GetFranchise(session dbr.SessionRunner, franchiseID string) ([]Franchise, error) {
+	franchises := []Franchise{}
+	_, err := session.Select("*").From("franchises").
+		Where("franchises.franchise_id=?", franchiseID).
+		OrderBy("franchises.first_game").
+		Load(&franchises)
+	return franchises, err
+}
+
+// GetFranchiseTeams returns every season of a franchise, whatever team code
+// it used at the time.
+func GetFranchiseTeams(session dbr.SessionRunner, franchiseID string) ([]Team, error) {
+	teams := []Team{}
+	_, err := session.Select("DISTINCT teams.*").From("teams").
+		Join("franchises", "franchises.team_code=teams.team_code AND "+
+			"teams.year >= YEAR(franchises.first_game) AND "+
+			"(franchises.last_game IS NULL OR teams.year <= YEAR(franchises.last_game))").
+		Where("franchises.franchise_id=?", franchiseID).
+		OrderBy("teams.year").
+		Load(&teams)
+	return teams, err
+}
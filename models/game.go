@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gocraft/dbr"
@@ -18,6 +19,9 @@ const (
 	PositionLeftField
 	PositionCenterField
 	PositionRightField
+	PositionDesignatedHitter
+	PositionPinchHitter
+	PositionPinchRunner
 )
 
 func (p Position) String() string {
@@ -31,8 +35,11 @@ func (p Position) String() string {
 		"Left Field",
 		"Center Field",
 		"Right Field",
+		"Designated Hitter",
+		"Pinch Hitter",
+		"Pinch Runner",
 	}
-	if p < PositionPitcher || p > PositionRightField {
+	if p < PositionPitcher || p > PositionPinchRunner {
 		return "Invalid Position"
 	}
 	return PositionName[p-1]
@@ -44,23 +51,97 @@ type Game struct {
 	Visitor int
 	Home    int
 	Played  time.Time
+	// VisitorScore, HomeScore and Outs, the length of the game in outs,
+	// come from the game logs and are -1 until one is loaded.
+	VisitorScore int `db:"visitor_score"`
+	HomeScore    int `db:"home_score"`
+	Outs         int
+	// Park is the park of the Site code, not valid when the parks file
+	// does not list it.
+	Park dbr.NullInt64 `db:"park_id"`
+	GameInfo
+	// Comments holds the com records that come before the first play, one
+	// entry per group of consecutive records. It is stored as JSON in
+	// CommentsJSON.
+	Comments     []string `db:"-"`
+	CommentsJSON string   `db:"comments"`
 }
 
 func NewGame(gameID string) Game {
 	g := Game{
-		GameID: gameID,
+		GameID:       gameID,
+		VisitorScore: -1,
+		HomeScore:    -1,
+		Outs:         -1,
+		GameInfo:     NewGameInfo(),
 	}
 	return g
 }
 
+func (g *Game) marshalComments() error {
+	if len(g.Comments) == 0 {
+		g.CommentsJSON = ""
+		return nil
+	}
+	b, err := json.Marshal(g.Comments)
+	if err != nil {
+		return err
+	}
+	g.CommentsJSON = string(b)
+	return nil
+}
+
 func (g *Game) Save(session dbr.SessionRunner) error {
-	_, err := session.InsertInto("games").
-		Columns("game_id", "played", "visitor", "home").
+	err := g.marshalComments()
+	if err != nil {
+		return err
+	}
+	_, err = session.InsertInto("games").
+		Columns("game_id", "played", "visitor", "home", "visitor_score",
+			"home_score", "outs", "park_id", "comments", "site", "game_number",
+			"start_time", "day_night", "use_dh", "ump_home", "ump_1b",
+			"ump_2b", "ump_3b", "attendance", "temperature", "wind_direction",
+			"wind_speed", "field_condition", "precipitation", "sky",
+			"time_of_game", "winning_pitcher", "losing_pitcher",
+			"save_pitcher", "how_scored", "pitches").
 		Record(g).
 		Exec()
 	return err
 }
 
+// Update saves the teams, score and length of an existing game and the
+// info a game log can fill in.
+func (g *Game) Update(session dbr.SessionRunner) error {
+	err := g.marshalComments()
+	if err != nil {
+		return err
+	}
+	_, err = session.Update("games").
+		Set("played", g.Played).
+		Set("visitor", g.Visitor).
+		Set("home", g.Home).
+		Set("visitor_score", g.VisitorScore).
+		Set("home_score", g.HomeScore).
+		Set("outs", g.Outs).
+		Set("park_id", g.Park).
+		Set("comments", g.CommentsJSON).
+		Set("site", g.Site).
+		Set("game_number", g.GameNumber).
+		Set("day_night", g.DayNight).
+		Set("ump_home", g.UmpireHome).
+		Set("ump_1b", g.UmpireFirst).
+		Set("ump_2b", g.UmpireSecond).
+		Set("ump_3b", g.UmpireThird).
+		Set("attendance", g.Attendance).
+		Set("time_of_game", g.TimeOfGame).
+		Set("winning_pitcher", g.WinningPitcher).
+		Set("losing_pitcher", g.LosingPitcher).
+		Set("save_pitcher", g.SavePitcher).
+		Where("id=?", g.ID).
+		Exec()
+	return err
+}
+
 func SaveGames(session dbr.SessionRunner, games []Game) error {
 	var err error
 	for _, g := range games {
@@ -76,5 +157,9 @@ func GetGame(session dbr.SessionRunner, gameID string) (Game, error) {
 	game := Game{}
 	_, err := session.Select("*").From("games").
 		Where("games.game_id=?", gameID).Load(&game)
+	if err != nil || game.CommentsJSON == "" {
+		return game, err
+	}
+	err = json.Unmarshal([]byte(game.CommentsJSON), &game.Comments)
 	return game, err
 }
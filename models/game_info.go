@@ -0,0 +1,213 @@
+package models
+
+type DayNight int
+
+const (
+	DayNightUnknown DayNight = iota
+	Day
+	Night
+)
+
+func (d DayNight) String() string {
+	names := [...]string{
+		"Unknown",
+		"Day",
+		"Night",
+	}
+	if d < DayNightUnknown || d > Night {
+		return "Invalid day/night"
+	}
+	return names[d]
+}
+
+type WindDirection int
+
+const (
+	WindUnknown WindDirection = iota
+	WindFromCenter
+	WindFromLeft
+	WindFromRight
+	WindLeftToRight
+	WindRightToLeft
+	WindToCenter
+	WindToLeft
+	WindToRight
+)
+
+func (w WindDirection) String() string {
+	names := [...]string{
+		"Unknown",
+		"From Center Field",
+		"From Left Field",
+		"From Right Field",
+		"Left to Right",
+		"Right to Left",
+		"To Center Field",
+		"To Left Field",
+		"To Right Field",
+	}
+	if w < WindUnknown || w > WindToRight {
+		return "Invalid wind direction"
+	}
+	return names[w]
+}
+
+type FieldCondition int
+
+const (
+	FieldUnknown FieldCondition = iota
+	FieldDry
+	FieldDamp
+	FieldWet
+	FieldSoaked
+)
+
+func (f FieldCondition) String() string {
+	names := [...]string{
+		"Unknown",
+		"Dry",
+		"Damp",
+		"Wet",
+		"Soaked",
+	}
+	if f < FieldUnknown || f > FieldSoaked {
+		return "Invalid field condition"
+	}
+	return names[f]
+}
+
+type Precipitation int
+
+const (
+	PrecipUnknown Precipitation = iota
+	PrecipNone
+	PrecipDrizzle
+	PrecipShowers
+	PrecipRain
+	PrecipSnow
+)
+
+func (p Precipitation) String() string {
+	names := [...]string{
+		"Unknown",
+		"None",
+		"Drizzle",
+		"Showers",
+		"Rain",
+		"Snow",
+	}
+	if p < PrecipUnknown || p > PrecipSnow {
+		return "Invalid precipitation"
+	}
+	return names[p]
+}
+
+type SkyCondition int
+
+const (
+	SkyUnknown SkyCondition = iota
+	SkySunny
+	SkyCloudy
+	SkyOvercast
+	SkyNight
+	SkyDome
+)
+
+func (s SkyCondition) String() string {
+	names := [...]string{
+		"Unknown",
+		"Sunny",
+		"Cloudy",
+		"Overcast",
+		"Night",
+		"Dome",
+	}
+	if s < SkyUnknown || s > SkyDome {
+		return "Invalid sky"
+	}
+	return names[s]
+}
+
+// ScoringMethod is how the scorer followed the game.
+type ScoringMethod int
+
+const (
+	ScoredUnknown ScoringMethod = iota
+	ScoredPark
+	ScoredTV
+	ScoredRadio
+)
+
+func (s ScoringMethod) String() string {
+	names := [...]string{
+		"Unknown",
+		"Park",
+		"TV",
+		"Radio",
+	}
+	if s < ScoredUnknown || s > ScoredRadio {
+		return "Invalid scoring method"
+	}
+	return names[s]
+}
+
+// PitchDetail is how much of each plate appearance was recorded: every
+// pitch, only the final count, or neither.
+type PitchDetail int
+
+const (
+	PitchDetailUnknown PitchDetail = iota
+	PitchDetailPitches
+	PitchDetailCount
+	PitchDetailNone
+)
+
+func (p PitchDetail) String() string {
+	names := [...]string{
+		"Unknown",
+		"Pitches",
+		"Count",
+		"None",
+	}
+	if p < PitchDetailUnknown || p > PitchDetailNone {
+		return "Invalid pitch detail"
+	}
+	return names[p]
+}
+
+// GameInfo holds the info records of a game other than the teams and date.
+// Umpires and pitchers are Retrosheet IDs; a missing umpire or save is "".
+// Temperature is in Fahrenheit with 0 meaning unknown, WindSpeed is in miles
+// per hour with -1 meaning unknown, and TimeOfGame is in minutes.
+type GameInfo struct {
+	Site           string         `db:"site"`
+	GameNumber     int            `db:"game_number"`
+	StartTime      string         `db:"start_time"`
+	DayNight       DayNight       `db:"day_night"`
+	UseDH          bool           `db:"use_dh"`
+	UmpireHome     string         `db:"ump_home"`
+	UmpireFirst    string         `db:"ump_1b"`
+	UmpireSecond   string         `db:"ump_2b"`
+	UmpireThird    string         `db:"ump_3b"`
+	Attendance     int            `db:"attendance"`
+	Temperature    int            `db:"temperature"`
+	WindDirection  WindDirection  `db:"wind_direction"`
+	WindSpeed      int            `db:"wind_speed"`
+	FieldCondition FieldCondition `db:"field_condition"`
+	Precipitation  Precipitation  `db:"precipitation"`
+	Sky            SkyCondition   `db:"sky"`
+	TimeOfGame     int            `db:"time_of_game"`
+	WinningPitcher string         `db:"winning_pitcher"`
+	LosingPitcher  string         `db:"losing_pitcher"`
+	SavePitcher    string         `db:"save_pitcher"`
+	HowScored      ScoringMethod  `db:"how_scored"`
+	Pitches        PitchDetail    `db:"pitches"`
+}
+
+// NewGameInfo returns a GameInfo with the values Retrosheet uses for
+// unknown numbers.
+func NewGameInfo() GameInfo {
+	return GameInfo{
+		WindSpeed: -1,
+	}
+}
@@ -0,0 +1,202 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gocraft/dbr"
+)
+
+// GameLogPerson is a player, manager or umpire as a game log gives them,
+// a Retrosheet id followed by a name.
+type GameLogPerson struct {
+	ID   string
+	Name string
+}
+
+// GameLogStarter is one spot in a starting lineup.
+type GameLogStarter struct {
+	GameLogPerson
+	Position Position
+}
+
+// TeamTotals are a team's offensive, pitching and defensive totals for a
+// game. Numbers the game log leaves blank are -1.
+type TeamTotals struct {
+	AtBats         int
+	Hits           int
+	Doubles        int
+	Triples        int
+	HomeRuns       int
+	RBI            int
+	SacHits        int
+	SacFlies       int
+	HitByPitch     int
+	Walks          int
+	IntWalks       int
+	StrikeOuts     int
+	StolenBases    int
+	CaughtSteal    int
+	GIDP           int
+	Interference   int
+	LeftOnBase     int
+	PitchersUsed   int
+	EarnedRuns     int
+	TeamEarnedRuns int
+	WildPitches    int
+	Balks          int
+	Putouts        int
+	Assists        int
+	Errors         int
+	PassedBalls    int
+	DoublePlays    int
+	TriplePlays    int
+}
+
+// GameLog is one game from a Retrosheet game log. Game logs reach back to
+// years without event files, so they are the only source for many games.
+// Numbers the game log leaves blank are -1.
+type GameLog struct {
+	Played            time.Time
+	GameNumber        int
+	DayOfWeek         string
+	Visitor           string
+	VisitorLeague     League
+	VisitorGameNumber int
+	Home              string
+	HomeLeague        League
+	HomeGameNumber    int
+	VisitorScore      int
+	HomeScore         int
+	Outs              int
+	DayNight          DayNight
+	Completion        string
+	Forfeit           string
+	Protest           string
+	Site              string
+	Attendance        int
+	TimeOfGame        int
+	VisitorLineScore  string
+	HomeLineScore     string
+	VisitorTotals     TeamTotals
+	HomeTotals        TeamTotals
+	UmpireHome        GameLogPerson
+	UmpireFirst       GameLogPerson
+	UmpireSecond      GameLogPerson
+	UmpireThird       GameLogPerson
+	UmpireLeft        GameLogPerson
+	UmpireRight       GameLogPerson
+	VisitorManager    GameLogPerson
+	HomeManager       GameLogPerson
+	WinningPitcher    GameLogPerson
+	LosingPitcher     GameLogPerson
+	SavePitcher       GameLogPerson
+	GameWinningRBI    GameLogPerson
+	VisitorPitcher    GameLogPerson
+	HomePitcher       GameLogPerson
+	VisitorLineup     [9]GameLogStarter
+	HomeLineup        [9]GameLogStarter
+	AdditionalInfo    string
+	Acquisition       string
+}
+
+// GameID returns the Retrosheet id of the game, the home team, the date
+// and the game number, e.g. ANA201804020.
+func (gl *GameLog) GameID() string {
+	return fmt.Sprintf("%s%s%d", gl.Home, gl.Played.Format("20060102"), gl.GameNumber)
+}
+
+// Enrich copies the score and length of the game into g, and fills in the
+// info that g does not have yet. Info already read from an event file is
+// left as it is.
+func (gl *GameLog) Enrich(g *Game) {
+	g.Played = gl.Played
+	g.GameNumber = gl.GameNumber
+	g.VisitorScore = gl.VisitorScore
+	g.HomeScore = gl.HomeScore
+	g.Outs = gl.Outs
+
+	fill := func(dst *string, val string) {
+		if *dst == "" {
+			*dst = val
+		}
+	}
+	fill(&g.Site, gl.Site)
+	fill(&g.UmpireHome, gl.UmpireHome.ID)
+	fill(&g.UmpireFirst, gl.UmpireFirst.ID)
+	fill(&g.UmpireSecond, gl.UmpireSecond.ID)
+	fill(&g.UmpireThird, gl.UmpireThird.ID)
+	fill(&g.WinningPitcher, gl.WinningPitcher.ID)
+	fill(&g.LosingPitcher, gl.LosingPitcher.ID)
+	fill(&g.SavePitcher, gl.SavePitcher.ID)
+	if g.DayNight == DayNightUnknown {
+		g.DayNight = gl.DayNight
+	}
+	if g.Attendance <= 0 && gl.Attendance > 0 {
+		g.Attendance = gl.Attendance
+	}
+	if g.TimeOfGame <= 0 && gl.TimeOfGame > 0 {
+		g.TimeOfGame = gl.TimeOfGame
+	}
+}
+
+// gameLogTeam returns the team a game log names, adding it when the team
+// file for the year was never loaded.
+func gameLogTeam(session dbr.SessionRunner, code string, year int, league League) (Team, error) {
+	team, err := GetTeam(session, code, year)
+	if err != nil || team.ID != 0 {
+		return team, err
+	}
+	team = Team{TeamCode: code, Year: year, League: league}
+	err = team.Save(session)
+	if err != nil {
+		return team, err
+	}
+	return GetTeam(session, code, year)
+}
+
+// SaveGameLog enriches the game the log describes, found by date, home team
+// and game number, or creates it when no event file had it.
+func SaveGameLog(session dbr.SessionRunner, gl GameLog) error {
+	game, err := GetGame(session, gl.GameID())
+	if err != nil {
+		return err
+	}
+	year := gl.Played.Year()
+	visitor, err := gameLogTeam(session, gl.Visitor, year, gl.VisitorLeague)
+	if err != nil {
+		return err
+	}
+	home, err := gameLogTeam(session, gl.Home, year, gl.HomeLeague)
+	if err != nil {
+		return err
+	}
+
+	if game.ID == 0 {
+		game = NewGame(gl.GameID())
+	}
+	game.Visitor = visitor.ID
+	game.Home = home.ID
+	gl.Enrich(&game)
+	if !game.Park.Valid {
+		err = game.SetPark(session)
+		if err != nil {
+			return err
+		}
+	}
+	if game.ID == 0 {
+		return game.Save(session)
+	}
+	return game.Update(session)
+}
+
+func SaveGameLogs(session dbr.SessionRunner, logs []GameLog) error {
+	var err error
+	for _, gl := range logs {
+		err = SaveGameLog(session, gl)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
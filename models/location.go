@@ -0,0 +1,73 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+type HitDirection int
+
+const (
+	DirectionNone HitDirection = iota
+	DirectionLeft
+	DirectionMiddle
+	DirectionRight
+)
+
+func (d HitDirection) String() string {
+	names := [...]string{
+		"",
+		"L",
+		"M",
+		"R",
+	}
+	if d < DirectionNone || d > DirectionRight {
+		return "Invalid direction"
+	}
+	return names[d]
+}
+
+type HitDepth int
+
+const (
+	DepthNormal HitDepth = iota
+	DepthShallow
+	DepthDeep
+	DepthExtraDeep
+)
+
+func (d HitDepth) String() string {
+	names := [...]string{
+		"",
+		"S",
+		"D",
+		"XD",
+	}
+	if d < DepthNormal || d > DepthExtraDeep {
+		return "Invalid depth"
+	}
+	return names[d]
+}
+
+// HitLocation is a zone on the Retrosheet hit location diagram, e.g. 78XD is
+// extra deep between the left and center fielders and 9LSF is a shallow foul
+// ball down the right field line.
+type HitLocation struct {
+	// Zone lists the fielders whose area the ball was hit to.
+	Zone      []Position   `json:",omitempty"`
+	Direction HitDirection `json:",omitempty"`
+	Depth     HitDepth     `json:",omitempty"`
+	Foul      bool         `json:",omitempty"`
+}
+
+func (hl HitLocation) String() string {
+	var zone strings.Builder
+	for _, p := range hl.Zone {
+		zone.WriteString(fmt.Sprintf("%d", int(p)))
+	}
+	foul := ""
+	if hl.Foul {
+		foul = "F"
+	}
+	return zone.String() + hl.Direction.String() + hl.Depth.String() + foul
+}
@@ -0,0 +1,62 @@
+package models
+
+import "github.com/gocraft/dbr"
+
+// Park is a ballpark from Retrosheet's parks file. ParkID is the code the
+// event files give in info,site and the game logs in their park field, e.g.
+// ANA01. End is not valid for parks still in use, and League is -1 for
+// parks, such as neutral sites, that the file gives no league for.
+type Park struct {
+	ID     int
+	ParkID string `db:"park_id"`
+	Name   string
+	AKA    string `db:"aka"`
+	City   string
+	State  string
+	Start  dbr.NullTime
+	End    dbr.NullTime
+	League League
+	Notes  string
+}
+
+func (p *Park) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("parks").
+		Columns("park_id", "name", "aka", "city", "state", "start", "end",
+			"league", "notes").
+		Record(p).
+		Exec()
+	return err
+}
+
+func SaveParks(session dbr.SessionRunner, parks []Park) error {
+	var err error
+	for _, p := range parks {
+		err = p.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+func GetPark(session dbr.SessionRunner, parkID string) (Park, error) {
+	park := Park{}
+	_, err := session.Select("*").From("parks").
+		Where("parks.park_id=?", parkID).Load(&park)
+	return park, err
+}
+
+// SetPark links g to the park of its Site code. g.Park is left not valid
+// when the parks file does not list the code.
+func (g *Game) SetPark(session dbr.SessionRunner) error {
+	g.Park = dbr.NullInt64{}
+	if g.Site == "" {
+		return nil
+	}
+	park, err := GetPark(session, g.Site)
+	if err != nil || park.ID == 0 {
+		return err
+	}
+	g.Park.Int64, g.Park.Valid = int64(park.ID), true
+	return nil
+}
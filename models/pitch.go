@@ -0,0 +1,79 @@
+package models
+
+type PitchType int
+
+const (
+	PitchBall PitchType = iota
+	PitchCalledStrike
+	PitchSwingingStrike
+	PitchFoul
+	PitchFoulTip
+	PitchFoulBunt
+	PitchFoulTipBunt
+	PitchMissedBunt
+	PitchInPlay
+	PitchInPlayOnPitchout
+	PitchHitBatter
+	PitchIntentionalBall
+	PitchPitchout
+	PitchSwingingOnPitchout
+	PitchFoulOnPitchout
+	PitchStrike
+	PitchAutomaticStrike
+	PitchAutomaticBall
+	PitchNoPitch
+	PitchUnknown
+	PitchPickoffFirst
+	PitchPickoffSecond
+	PitchPickoffThird
+)
+
+func (pt PitchType) String() string {
+	names := [...]string{
+		"Ball",
+		"Called strike",
+		"Swinging strike",
+		"Foul",
+		"Foul tip",
+		"Foul bunt",
+		"Foul tip on bunt",
+		"Missed bunt",
+		"In play",
+		"In play on pitchout",
+		"Hit batter",
+		"Intentional ball",
+		"Pitchout",
+		"Swinging on pitchout",
+		"Foul on pitchout",
+		"Strike",
+		"Automatic strike",
+		"Automatic ball",
+		"No pitch",
+		"Unknown",
+		"Pickoff throw to first",
+		"Pickoff throw to second",
+		"Pickoff throw to third",
+	}
+
+	if pt < PitchBall || pt > PitchPickoffThird {
+		return "Invalid pitch type"
+	}
+	return names[pt]
+}
+
+type Pitch struct {
+	Type PitchType
+	// RunnerGoing is set when a runner was going on the pitch (>).
+	RunnerGoing bool `json:",omitempty"`
+	// Blocked is set when the catcher blocked the pitch (*).
+	Blocked bool `json:",omitempty"`
+	// CatcherPickoff is set when the pickoff throw came from the catcher (+).
+	CatcherPickoff bool `json:",omitempty"`
+	// NonPitchAction is set on the first pitch after a play that did not
+	// involve the batter (.).
+	NonPitchAction bool `json:",omitempty"`
+}
+
+func (p Pitch) String() string {
+	return p.Type.String()
+}
@@ -25,13 +25,29 @@ func (h Handed) String() string {
 	return HandedName[h]
 }
 
+// Player is a player from the roster files. The biographical fields come
+// from the biofile and stay empty, or NULL, until it is loaded. Height is
+// in inches and Weight in pounds, with 0 meaning unknown.
 type Player struct {
-	ID        int
-	PlayerID  string `db:"player_id"`
-	FirstName string `db:"firstname"`
-	LastName  string `db:"lastname"`
-	Bats      Handed
-	Throws    Handed
+	ID           int
+	PlayerID     string `db:"player_id"`
+	FirstName    string `db:"firstname"`
+	LastName     string `db:"lastname"`
+	Bats         Handed
+	Throws       Handed
+	Nickname     string
+	BirthDate    dbr.NullTime `db:"birth_date"`
+	BirthCity    string       `db:"birth_city"`
+	BirthState   string       `db:"birth_state"`
+	BirthCountry string       `db:"birth_country"`
+	Debut        dbr.NullTime
+	LastGame     dbr.NullTime `db:"last_game"`
+	DeathDate    dbr.NullTime `db:"death_date"`
+	DeathCity    string       `db:"death_city"`
+	DeathState   string       `db:"death_state"`
+	DeathCountry string       `db:"death_country"`
+	Height       int
+	Weight       int
 }
 
 func (p *Player) Save(session dbr.SessionRunner) error {
@@ -65,6 +81,40 @@ func SavePlayers(session dbr.SessionRunner, players []Player) error {
 	return err
 }
 
+// SaveBiography updates the biographical fields of the player with
+// p.PlayerID. People the roster files never listed, such as managers and
+// umpires who did not play, are not added.
+func (p *Player) SaveBiography(session dbr.SessionRunner) error {
+	_, err := session.Update("players").
+		Set("nickname", p.Nickname).
+		Set("birth_date", p.BirthDate).
+		Set("birth_city", p.BirthCity).
+		Set("birth_state", p.BirthState).
+		Set("birth_country", p.BirthCountry).
+		Set("debut", p.Debut).
+		Set("last_game", p.LastGame).
+		Set("death_date", p.DeathDate).
+		Set("death_city", p.DeathCity).
+		Set("death_state", p.DeathState).
+		Set("death_country", p.DeathCountry).
+		Set("height", p.Height).
+		Set("weight", p.Weight).
+		Where("player_id=?", p.PlayerID).
+		Exec()
+	return err
+}
+
+func SaveBiographies(session dbr.SessionRunner, players []Player) error {
+	var err error
+	for _, p := range players {
+		err = p.SaveBiography(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
 func GetPlayer(session dbr.SessionRunner, playerID string) (Player, error) {
 	player := Player{}
 	_, err := session.Select("*").From("players").
@@ -0,0 +1,42 @@
+package models
+
+import "github.com/gocraft/dbr"
+
+// RosterEntry is a player on a team's roster for a season. Position is the
+// Retrosheet code of the player's primary position, e.g. P, SS or OF, and
+// Bats and Throws are as listed on that roster.
+type RosterEntry struct {
+	ID       int
+	Player   int `db:"player_id"`
+	Team     int `db:"team_id"`
+	Year     int
+	Bats     Handed
+	Throws   Handed
+	Position string
+}
+
+func (re *RosterEntry) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("rosters").
+		Columns("player_id", "team_id", "year", "bats", "throws", "position").
+		Record(re).
+		Exec()
+	return err
+}
+
+func SaveRosterEntries(session dbr.SessionRunner, entries []RosterEntry) error {
+	var err error
+	for _, re := range entries {
+		err = re.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+func GetRoster(session dbr.SessionRunner, teamID int) ([]RosterEntry, error) {
+	entries := []RosterEntry{}
+	_, err := session.Select("*").From("rosters").
+		Where("rosters.team_id=?", teamID).Load(&entries)
+	return entries, err
+}
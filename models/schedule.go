@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocraft/dbr"
+)
+
+// ScheduledGame is a game as it appeared on the original schedule of a
+// season. A postponed or cancelled game keeps its original date, with the
+// reason in Postponement and the date it was made up on, if it was, in
+// Makeup. The game that was played is found in games by home team, date
+// and game number.
+type ScheduledGame struct {
+	ID                int
+	Scheduled         time.Time
+	GameNumber        int    `db:"game_number"`
+	DayOfWeek         string `db:"day_of_week"`
+	Visitor           int
+	VisitorGameNumber int `db:"visitor_game_number"`
+	Home              int
+	HomeGameNumber    int      `db:"home_game_number"`
+	DayNight          DayNight `db:"day_night"`
+	Postponement      string
+	// MakeupInfo is the makeup field as Retrosheet gives it, which may
+	// list several dates or explain why there was no makeup.
+	MakeupInfo string `db:"makeup_info"`
+	Makeup     dbr.NullTime
+}
+
+// Postponed reports whether the game was not played on its scheduled date.
+func (s *ScheduledGame) Postponed() bool {
+	return s.Postponement != ""
+}
+
+func (s *ScheduledGame) Save(session dbr.SessionRunner) error {
+	_, err := session.InsertInto("schedules").
+		Columns("scheduled", "game_number", "day_of_week", "visitor",
+			"visitor_game_number", "home", "home_game_number", "day_night",
+			"postponement", "makeup_info", "makeup").
+		Record(s).
+		Exec()
+	return err
+}
+
+func SaveSchedule(session dbr.SessionRunner, schedule []ScheduledGame) error {
+	var err error
+	for _, s := range schedule {
+		err = s.Save(session)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+// GetSchedule returns the scheduled games of a team, home and away, in
+// order.
+func GetSchedule(session dbr.SessionRunner, teamID int) ([]ScheduledGame, error) {
+	schedule := []ScheduledGame{}
+	_, err := session.Select("*").From("schedules").
+		Where("schedules.visitor=? OR schedules.home=?", teamID, teamID).
+		OrderBy("schedules.scheduled").
+		OrderBy("schedules.game_number").
+		Load(&schedule)
+	return schedule, err
+}
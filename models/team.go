@@ -5,16 +5,40 @@ import "github.com/gocraft/dbr"
 type League int
 
 const (
-	American League = 0
-	National League = 1
+	American            League = 0
+	National            League = 1
+	Federal             League = 2
+	AmericanAssociation League = 3
+	UnionAssociation    League = 4
+	Players             League = 5
+	NegroNational       League = 6
+	EasternColored      League = 7
+	AmericanNegro       League = 8
+	EastWest            League = 9
+	NegroSouthern       League = 10
+	NegroNationalII     League = 11
+	NegroAmerican       League = 12
+	NationalAssociation League = 13
 )
 
 func (l League) String() string {
 	LeagueNames := [...]string{
 		"American",
 		"National",
+		"Federal",
+		"American Association",
+		"Union Association",
+		"Players",
+		"Negro National",
+		"Eastern Colored",
+		"American Negro",
+		"East-West",
+		"Negro Southern",
+		"Negro National (II)",
+		"Negro American",
+		"National Association",
 	}
-	if l < American || l > National {
+	if l < American || l > NationalAssociation {
 		return "Invalid League"
 	}
 	return LeagueNames[l]
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"fmt"
 	"log"
+	"os"
 	"sync"
 
 	"github.com/wazupwiddat/retrosheet/db"
@@ -25,6 +26,18 @@ func unique(slice []models.Player) []models.Player {
 	return list
 }
 
+// skipParseErrors logs the records a reader skipped and lets the load carry
+// on with the rest. Any other error is returned and aborts the load.
+func skipParseErrors(err error) error {
+	if pe, ok := err.(readers.ParseErrors); ok {
+		for _, e := range pe {
+			log.Println(e)
+		}
+		return nil
+	}
+	return err
+}
+
 func LoadTeams(r *zip.ReadCloser) error {
 	conn, err := db.Open("mysql", "")
 	if err != nil {
@@ -33,7 +46,12 @@ func LoadTeams(r *zip.ReadCloser) error {
 	}
 	session := conn.NewSession(nil)
 
-	teams := readers.ReadTeams(r)
+	teams, err := readers.ReadTeams(r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
 	tx, err := session.Begin()
 	if err != nil {
 		log.Println(err)
@@ -64,7 +82,12 @@ func LoadPlayers(r *zip.ReadCloser) error {
 	}
 	session := conn.NewSession(nil)
 
-	players := readers.ReadPlayers(r)
+	players, err := readers.ReadPlayers(r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
 	players = unique(players)
 	tx, err := session.Begin()
 	if err != nil {
@@ -92,7 +115,12 @@ func LoadGames(r *zip.ReadCloser) error {
 	}
 	session := conn.NewSession(nil)
 
-	games := readers.ReadGames(session, r)
+	games, err := readers.ReadGames(session, r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
 	tx, err := session.Begin()
 	if err != nil {
 		log.Println(err)
@@ -120,8 +148,13 @@ func LoadGamesEvents(r *zip.ReadCloser) error {
 	session := conn.NewSession(nil)
 
 	log.Println("Reading games events...")
-	events := readers.ReadGamesEvents(session, r)
-	fmt.Println(len(events))
+	records, err := readers.ReadGamesEvents(session, r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	fmt.Println(len(records.Events))
 	tx, err := session.Begin()
 	if err != nil {
 		log.Println(err)
@@ -131,7 +164,278 @@ func LoadGamesEvents(r *zip.ReadCloser) error {
 	defer tx.RollbackUnlessCommitted()
 
 	log.Println("Saving games events...")
-	err = models.SaveGamesEvents(tx, events)
+	err = models.SaveGamesEvents(tx, records.Events)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	err = models.SaveAppearances(tx, records.Appearances)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	err = models.SaveEarnedRuns(tx, records.EarnedRuns)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// LoadFranchises loads Retrosheet's franchise file, which is downloaded
+// next to the yearly archives rather than inside them.
+func LoadFranchises(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer f.Close()
+
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	franchises, err := readers.ReadFranchises(f)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveFranchises(tx, franchises)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// LoadParks loads Retrosheet's parks file, which is downloaded next to the
+// yearly archives. Parks must be loaded before the games that link to them.
+func LoadParks(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer f.Close()
+
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	parks, err := readers.ReadParks(f)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveParks(tx, parks)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// LoadBiographies adds the biofile, which is downloaded next to the yearly
+// archives, to the players already loaded from the rosters.
+func LoadBiographies(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer f.Close()
+
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	players, err := readers.ReadBiographies(f)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveBiographies(tx, players)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+func LoadRosters(r *zip.ReadCloser) error {
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	entries, err := readers.ReadRosters(session, r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveRosterEntries(tx, entries)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+func LoadBoxScores(r *zip.ReadCloser) error {
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	box, err := readers.ReadBoxScores(session, r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveBoxScore(tx, box)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// LoadGameLogs enriches the games already loaded from event files with the
+// game logs in the archive, and adds the games no event file covers.
+func LoadGameLogs(r *zip.ReadCloser) error {
+	lock.Lock()
+
+	defer lock.Unlock()
+
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	logs, err := readers.ReadGameLogs(r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveGameLogs(tx, logs)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// LoadSchedules loads the original schedules in the archive. The teams
+// must already be loaded, from the event files or the game logs.
+func LoadSchedules(r *zip.ReadCloser) error {
+	conn, err := db.Open("mysql", "")
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	session := conn.NewSession(nil)
+
+	schedule, err := readers.ReadSchedules(session, r)
+	err = skipParseErrors(err)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	tx, err := session.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	defer tx.RollbackUnlessCommitted()
+
+	err = models.SaveSchedule(tx, schedule)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -0,0 +1,75 @@
+package readers
+
+import (
+	"strconv"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// parseAdjustment parses a badj, padj, ladj, radj or presadj record. It
+// returns the adjustment, the Retrosheet ID of the player it names, if any,
+// and on failure the offending field and the reason.
+func parseAdjustment(recordType models.EventType, record []string) (models.Adjustment, string, int, string) {
+	adj := models.Adjustment{Type: recordType}
+	if len(record) < 3 {
+		return adj, "", -1, "too few fields"
+	}
+	switch recordType {
+	case models.BatterAdj, models.PitcherAdj:
+		adj.Hand = ParseHanded(record[2])
+		if adj.Hand != models.RightHanded && adj.Hand != models.LeftHanded {
+			return adj, "", 2, "unknown handedness"
+		}
+		return adj, record[1], 0, ""
+	case models.LineupAdj:
+		side, ok := ParseTeamSide(record[1])
+		if !ok {
+			return adj, "", 1, "unknown team side"
+		}
+		order, err := strconv.Atoi(record[2])
+		if err != nil || order < 1 || order > 9 {
+			return adj, "", 2, "invalid batting order"
+		}
+		adj.Side = side
+		adj.BattingOrder = order
+		return adj, "", 0, ""
+	case models.RunnerAdj, models.PitcherResponsibilityAdj:
+		base, ok := baseMap[record[2]]
+		if !ok || base < 1 || base > 3 {
+			return adj, "", 2, "invalid base"
+		}
+		adj.Base = base
+		return adj, record[1], 0, ""
+	}
+	return adj, "", 0, "not an adjustment"
+}
+
+// endsPlateAppearance reports whether play finishes the batter's turn at
+// the plate. Baserunning plays happen during a plate appearance, so batter
+// and pitcher hand adjustments stay in effect across them.
+func endsPlateAppearance(play models.BasicPlay) bool {
+	switch play {
+	case models.StolenBase, models.CaughtStealing, models.PickOff,
+		models.PickOffCaughtStealing, models.WildPitch, models.PassedBall,
+		models.Balk, models.DefensiveIndifference, models.OtherAdvance,
+		models.NoPlay:
+		return false
+	}
+	return true
+}
+
+// carryAdjustments returns the adjustments pending before a play that stay
+// in effect for the next one: the batter and pitcher hand adjustments of a
+// plate appearance that has not ended yet.
+func carryAdjustments(pending []models.Adjustment, ed models.EventDetail) []models.Adjustment {
+	next := []models.Adjustment{}
+	if endsPlateAppearance(ed.Play) {
+		return next
+	}
+	for _, adj := range pending {
+		if adj.Type == models.BatterAdj || adj.Type == models.PitcherAdj {
+			next = append(next, adj)
+		}
+	}
+	return next
+}
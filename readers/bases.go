@@ -0,0 +1,99 @@
+package readers
+
+import (
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// batterBaseMap is the base the batter reaches on a play when the play does
+// not say otherwise.
+var batterBaseMap = map[models.BasicPlay]int{
+	models.Single:              1,
+	models.Walk:                1,
+	models.IntentionalWalk:     1,
+	models.HitByPitch:          1,
+	models.Error:               1,
+	models.FieldersChoice:      1,
+	models.CatcherInterference: 1,
+	models.Double:              2,
+	models.GroundRuleDouble:    2,
+	models.Triple:              3,
+	models.HomeRun:             4,
+}
+
+// BaseState tracks the occupied bases through a half inning so that the
+// advances Retrosheet leaves out of a play can be filled in. Reset it at
+// the start of every half inning.
+type BaseState struct {
+	occupied [4]bool
+}
+
+func (bs *BaseState) Reset() {
+	bs.occupied = [4]bool{}
+}
+
+// Place puts a runner on base without a play, as for the runner who starts
+// an extra inning on second.
+func (bs *BaseState) Place(base int) {
+	if base > 0 && base < 4 {
+		bs.occupied[base] = true
+	}
+}
+
+// Occupied reports whether there is a runner on base 1, 2 or 3.
+func (bs *BaseState) Occupied(base int) bool {
+	return base > 0 && base < 4 && bs.occupied[base]
+}
+
+// Apply adds the implied batter and forced runner advances to ed, marked
+// Implied, and then moves the runners.
+func (bs *BaseState) Apply(ed *models.EventDetail) {
+	moved := map[int]bool{}
+	for _, ra := range ed.RunnerAdv {
+		moved[ra.StartBase] = true
+	}
+
+	if base, ok := batterBaseMap[ed.Play]; ok && !moved[0] {
+		ed.RunnerAdv = append(ed.RunnerAdv, models.RunnerAdvance{
+			StartBase:  0,
+			FinishBase: base,
+			Implied:    true,
+		})
+	}
+
+	// A batter who reaches first forces the runner on first, who in turn
+	// forces the runner on second, and so on.
+	if bs.batterReaches(ed, 1) {
+		for base := 1; base < 4 && bs.occupied[base]; base++ {
+			if moved[base] {
+				continue
+			}
+			ed.RunnerAdv = append(ed.RunnerAdv, models.RunnerAdvance{
+				StartBase:  base,
+				FinishBase: base + 1,
+				Implied:    true,
+			})
+		}
+	}
+
+	next := bs.occupied
+	for _, ra := range ed.RunnerAdv {
+		if ra.StartBase > 0 {
+			next[ra.StartBase] = false
+		}
+	}
+	for _, ra := range ed.RunnerAdv {
+		if !ra.Out && ra.FinishBase > 0 && ra.FinishBase < 4 {
+			next[ra.FinishBase] = true
+		}
+	}
+	bs.occupied = next
+}
+
+func (bs *BaseState) batterReaches(ed *models.EventDetail, base int) bool {
+	for _, ra := range ed.RunnerAdv {
+		if ra.StartBase == 0 {
+			return !ra.Out && ra.FinishBase == base
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package readers_test
+
+import (
+	"testing"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+func TestBaseStateApply(t *testing.T) {
+	convey.Convey("Given a half inning...", t, func() {
+		implied := func(start, finish int) models.RunnerAdvance {
+			return models.RunnerAdvance{StartBase: start, FinishBase: finish, Implied: true}
+		}
+		tests := []struct {
+			t        string
+			v        []models.RunnerAdvance
+			occupied [4]bool
+		}{
+			{
+				"W",
+				[]models.RunnerAdvance{implied(0, 1)},
+				[4]bool{false, true, false, false},
+			},
+			{
+				"W",
+				[]models.RunnerAdvance{implied(0, 1), implied(1, 2)},
+				[4]bool{false, true, true, false},
+			},
+			{
+				"S8.2-H",
+				[]models.RunnerAdvance{
+					{StartBase: 2, FinishBase: 4},
+					implied(0, 1),
+					implied(1, 2),
+				},
+				[4]bool{false, true, true, false},
+			},
+			{
+				"64(1)3/GDP/G6",
+				[]models.RunnerAdvance{{StartBase: 1, FinishBase: 2, Out: true}},
+				[4]bool{false, false, true, false},
+			},
+			{
+				"D7/G5.2-H",
+				[]models.RunnerAdvance{
+					{StartBase: 2, FinishBase: 4},
+					implied(0, 2),
+				},
+				[4]bool{false, false, true, false},
+			},
+			{
+				"HP",
+				[]models.RunnerAdvance{implied(0, 1)},
+				[4]bool{false, true, true, false},
+			},
+			{
+				"HR/F7.2-H;1-H",
+				[]models.RunnerAdvance{
+					{StartBase: 2, FinishBase: 4},
+					{StartBase: 1, FinishBase: 4},
+					implied(0, 4),
+				},
+				[4]bool{},
+			},
+		}
+		var bases readers.BaseState
+		for _, test := range tests {
+			ed, err := readers.ParseEventDetail(test.t)
+			convey.So(err, convey.ShouldBeNil)
+			bases.Apply(&ed)
+			convey.So(ed.RunnerAdv, convey.ShouldResemble, test.v)
+			for base := 1; base < 4; base++ {
+				convey.So(bases.Occupied(base), convey.ShouldEqual, test.occupied[base])
+			}
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package readers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gocraft/dbr"
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// BioFile is Retrosheet's file of biographical data on every player,
+// manager, coach and umpire, which is not part of the yearly event archives.
+const BioFile = "BIOFILE.TXT"
+
+// ReadBiographies reads the biofile into Players that carry only their
+// PlayerID, names and biographical fields. Columns are found by the names
+// in the header, without spaces, so BIRTH CITY and BIRTHCITY are the same.
+// Dates Retrosheet only knows in part, such as a bare year, are left NULL.
+// Records that fail to parse are skipped and returned as ParseErrors.
+func ReadBiographies(r io.Reader) ([]models.Player, error) {
+	players := []models.Player{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return players, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToUpper(strings.Replace(name, " ", "", -1))] = i
+	}
+	if _, ok := columns["PLAYERID"]; !ok {
+		errs = append(errs, newParseError(BioFile, 1, "biofile", header, -1, "no PLAYERID column"))
+		return players, errs.err()
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return players, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(BioFile, line, "biofile", record, -1, err.Error()))
+			continue
+		}
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		date := func(name string) dbr.NullTime {
+			d, err := time.Parse("1/2/2006", field(name))
+			if err != nil {
+				return dbr.NullTime{}
+			}
+			return dbr.NullTime{Time: d, Valid: true}
+		}
+
+		p := models.Player{
+			PlayerID:     field("PLAYERID"),
+			LastName:     field("LAST"),
+			FirstName:    field("FIRST"),
+			Nickname:     field("NICKNAME"),
+			BirthDate:    date("BIRTHDATE"),
+			BirthCity:    field("BIRTHCITY"),
+			BirthState:   field("BIRTHSTATE"),
+			BirthCountry: field("BIRTHCOUNTRY"),
+			Debut:        date("PLAYDEBUT"),
+			LastGame:     date("PLAYLASTGAME"),
+			DeathDate:    date("DEATHDATE"),
+			DeathCity:    field("DEATHCITY"),
+			DeathState:   field("DEATHSTATE"),
+			DeathCountry: field("DEATHCOUNTRY"),
+		}
+		if p.PlayerID == "" {
+			errs = append(errs, newParseError(BioFile, line, "biofile", record, columns["PLAYERID"], "no player id"))
+			continue
+		}
+		p.Height, err = parseHeight(field("HEIGHT"))
+		if err != nil {
+			errs = append(errs, newParseError(BioFile, line, "biofile", record, columns["HEIGHT"], err.Error()))
+			continue
+		}
+		if w := field("WEIGHT"); w != "" {
+			p.Weight, err = strconv.Atoi(w)
+			if err != nil {
+				errs = append(errs, newParseError(BioFile, line, "biofile", record, columns["WEIGHT"], "not a number"))
+				continue
+			}
+		}
+		players = append(players, p)
+	}
+	return players, errs.err()
+}
+
+// parseHeight parses a height in feet and inches, such as 6-02, into
+// inches. An empty height is unknown and returns 0.
+func parseHeight(val string) (int, error) {
+	if val == "" {
+		return 0, nil
+	}
+	parts := strings.FieldsFunc(val, func(r rune) bool { return !unicode.IsDigit(r) })
+	if len(parts) == 0 || len(parts) > 2 {
+		return 0, fmt.Errorf("bad height %q", val)
+	}
+	feet, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, err
+	}
+	inches := 0
+	if len(parts) == 2 {
+		inches, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, err
+		}
+	}
+	return feet*12 + inches, nil
+}
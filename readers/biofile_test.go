@@ -0,0 +1,46 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const bioFile = `PLAYERID,LAST,FIRST,NICKNAME,BIRTHDATE,BIRTH CITY,BIRTH STATE,BIRTH COUNTRY,PLAY DEBUT,PLAY LASTGAME,MGR DEBUT,MGR LASTGAME,COACH DEBUT,COACH LASTGAME,UMP DEBUT,UMP LASTGAME,DEATHDATE,DEATH CITY,DEATH STATE,DEATH COUNTRY,BATS,THROWS,HOF,BIRTH NAME,NAME CHANGE,BAT CHANGE,HEIGHT,WEIGHT
+aaroh101,Aaron,Hank,Hammerin' Hank,02/05/1934,Mobile,AL,USA,04/13/1954,10/03/1976,,,,,,,01/22/2021,Atlanta,GA,USA,R,R,HOF,Henry Louis Aaron,,,6-00,180
+ohtas001,Ohtani,Shohei,,07/05/1994,Oshu,Iwate,Japan,03/29/2018,,,,,,,,,,,,L,R,,,,,6-04,210
+wrigh101,Wright,Harry,,1835,Sheffield,,England,05/05/1871,,,,,,,,10/03/1895,Atlantic City,NJ,USA,R,R,HOF,,,,5-09,x
+`
+
+func TestReadBiographies(t *testing.T) {
+	convey.Convey("Given a biofile ...", t, func() {
+		players, err := readers.ReadBiographies(strings.NewReader(bioFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 1)
+		convey.So(pe[0].Line, convey.ShouldEqual, 4)
+		convey.So(pe[0].Field, convey.ShouldEqual, 27)
+
+		convey.So(len(players), convey.ShouldEqual, 2)
+		p := players[0]
+		convey.So(p.PlayerID, convey.ShouldEqual, "aaroh101")
+		convey.So(p.Nickname, convey.ShouldEqual, "Hammerin' Hank")
+		convey.So(p.BirthDate.Time, convey.ShouldEqual, time.Date(1934, 2, 5, 0, 0, 0, 0, time.UTC))
+		convey.So(p.BirthCity, convey.ShouldEqual, "Mobile")
+		convey.So(p.Debut.Time, convey.ShouldEqual, time.Date(1954, 4, 13, 0, 0, 0, 0, time.UTC))
+		convey.So(p.LastGame.Valid, convey.ShouldBeTrue)
+		convey.So(p.DeathDate.Valid, convey.ShouldBeTrue)
+		convey.So(p.DeathCity, convey.ShouldEqual, "Atlanta")
+		convey.So(p.Height, convey.ShouldEqual, 72)
+		convey.So(p.Weight, convey.ShouldEqual, 180)
+
+		p = players[1]
+		convey.So(p.BirthCountry, convey.ShouldEqual, "Japan")
+		convey.So(p.LastGame.Valid, convey.ShouldBeFalse)
+		convey.So(p.DeathDate.Valid, convey.ShouldBeFalse)
+		convey.So(p.Height, convey.ShouldEqual, 76)
+	})
+}
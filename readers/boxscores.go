@@ -0,0 +1,232 @@
+package readers
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gocraft/dbr"
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// IsEventFile reports whether name is a play-by-play event file of the
+// American (.EVA) or National (.EVN) league.
+func IsEventFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".EVA" || ext == ".EVN"
+}
+
+// IsBoxScoreFile reports whether name is a box score event file, used for
+// the seasons that have no play-by-play.
+func IsBoxScoreFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".EBA" || ext == ".EBN"
+}
+
+// boxScoreRecords are the record types that only box score files have,
+// which are not game events.
+var boxScoreRecords = map[string]bool{
+	"stat":  true,
+	"line":  true,
+	"event": true,
+}
+
+// ReadBoxScores reads the batting, pitching and fielding lines and the line
+// scores of every box score file in the archive. Records that fail to parse
+// are skipped and returned as ParseErrors.
+func ReadBoxScores(sess *dbr.Session, r *zip.ReadCloser) (models.BoxScore, error) {
+	box := models.BoxScore{}
+	errs := ParseErrors{}
+	for _, f := range r.File {
+		if !IsBoxScoreFile(f.Name) {
+			continue
+		}
+		fmt.Printf("Reading %s:\n", f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			return box, err
+		}
+
+		b, err := ReadBoxScoresFromFile(sess, f.Name, rc)
+		rc.Close()
+		box.Batting = append(box.Batting, b.Batting...)
+		box.Pitching = append(box.Pitching, b.Pitching...)
+		box.Fielding = append(box.Fielding, b.Fielding...)
+		box.LineScores = append(box.LineScores, b.LineScores...)
+		if pe, ok := err.(ParseErrors); ok {
+			errs = append(errs, pe...)
+		} else if err != nil {
+			return box, err
+		}
+	}
+	return box, errs.err()
+}
+
+// ReadBoxScoresFromFile reads a single box score file; name is only used to
+// report errors. Stat records other than bline, pline and dline, such as
+// the team lines, are skipped.
+func ReadBoxScoresFromFile(sess *dbr.Session, name string, file io.Reader) (models.BoxScore, error) {
+	box := models.BoxScore{
+		Batting:    []models.BattingLine{},
+		Pitching:   []models.PitchingLine{},
+		Fielding:   []models.FieldingLine{},
+		LineScores: []models.LineScore{},
+	}
+	errs := ParseErrors{}
+	var game models.Game
+	// records are skipped until the id of a game that was found
+	skip := true
+	reader := NewGameReader(file)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return box, err
+		}
+		if err != nil {
+			errs = append(errs, newParseError(name, reader.Line(), "", record, -1, err.Error()))
+			continue
+		}
+		if len(record) == 0 {
+			continue
+		}
+
+		if record[0] == "id" {
+			if len(record) < 2 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, -1, "too few fields"))
+				skip = true
+				continue
+			}
+			game, err = models.GetGame(sess, record[1])
+			skip = err != nil || game.ID == 0
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, err.Error()))
+			} else if game.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, "game not found"))
+			}
+			continue
+		}
+		if skip {
+			continue
+		}
+
+		switch record[0] {
+		case "line":
+			lines, field, reason := parseLineScore(record)
+			if reason != "" {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, field, reason))
+				continue
+			}
+			for i := range lines {
+				lines[i].GameID = game.ID
+			}
+			box.LineScores = append(box.LineScores, lines...)
+		case "stat":
+			if len(record) < 4 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, -1, "too few fields"))
+				continue
+			}
+			if record[1] != "bline" && record[1] != "pline" && record[1] != "dline" {
+				continue
+			}
+			player, err := models.GetPlayer(sess, record[2])
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 2, err.Error()))
+				continue
+			}
+			if player.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 2, "player not found"))
+				continue
+			}
+			side, ok := ParseTeamSide(record[3])
+			if !ok {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 3, "unknown team side"))
+				continue
+			}
+			var field int
+			var reason string
+			switch record[1] {
+			case "bline":
+				b := models.BattingLine{GameID: game.ID, Player: player.ID, Side: side}
+				field, reason = parseStats(record, 4, &b.BattingOrder, &b.Sequence,
+					&b.AtBats, &b.Runs, &b.Hits, &b.Doubles, &b.Triples, &b.HomeRuns,
+					&b.RBI, &b.SacHits, &b.SacFlies, &b.HitByPitch, &b.Walks,
+					&b.IntWalks, &b.StrikeOuts, &b.StolenBases, &b.CaughtSteal,
+					&b.GIDP, &b.Interference)
+				if reason == "" {
+					box.Batting = append(box.Batting, b)
+				}
+			case "pline":
+				p := models.PitchingLine{GameID: game.ID, Player: player.ID, Side: side}
+				field, reason = parseStats(record, 4, &p.Sequence, &p.Outs,
+					&p.NoOuts, &p.BattersFaced, &p.Hits, &p.Doubles, &p.Triples,
+					&p.HomeRuns, &p.Runs, &p.EarnedRuns, &p.Walks, &p.IntWalks,
+					&p.StrikeOuts, &p.HitByPitch, &p.WildPitches, &p.Balks,
+					&p.SacHits, &p.SacFlies)
+				if reason == "" {
+					box.Pitching = append(box.Pitching, p)
+				}
+			case "dline":
+				d := models.FieldingLine{GameID: game.ID, Player: player.ID, Side: side}
+				var position int
+				field, reason = parseStats(record, 4, &d.Sequence, &position,
+					&d.Outs, &d.Putouts, &d.Assists, &d.Errors, &d.DoublePlays,
+					&d.TriplePlays, &d.PassedBalls)
+				d.Position = models.Position(position)
+				if reason == "" {
+					box.Fielding = append(box.Fielding, d)
+				}
+			}
+			if reason != "" {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, field, reason))
+			}
+		}
+	}
+	return box, errs.err()
+}
+
+// parseStats parses the numeric fields of record from start on into stats,
+// in order. On failure it returns the offending field and the reason.
+func parseStats(record []string, start int, stats ...*int) (int, string) {
+	if len(record) < start+len(stats) {
+		return -1, "too few fields"
+	}
+	for i, stat := range stats {
+		v, err := strconv.Atoi(record[start+i])
+		if err != nil {
+			return start + i, "not a number"
+		}
+		*stat = v
+	}
+	return 0, ""
+}
+
+// parseLineScore parses a line,<side>,<runs>... record into one LineScore
+// per inning the team batted in.
+func parseLineScore(record []string) ([]models.LineScore, int, string) {
+	lines := []models.LineScore{}
+	if len(record) < 3 {
+		return lines, -1, "too few fields"
+	}
+	side, ok := ParseTeamSide(record[1])
+	if !ok {
+		return lines, 1, "unknown team side"
+	}
+	for i, val := range record[2:] {
+		// x is the bottom of the last inning when the home team did not
+		// need to bat
+		if val == "x" {
+			continue
+		}
+		runs, err := strconv.Atoi(val)
+		if err != nil {
+			return lines, i + 2, "not a number"
+		}
+		lines = append(lines, models.LineScore{Side: side, Inning: i + 1, Runs: runs})
+	}
+	return lines, 0, ""
+}
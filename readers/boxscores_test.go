@@ -0,0 +1,175 @@
+package readers_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const boxScoreFile = `id,BRO191404100
+version,3
+info,visteam,PIT
+info,hometeam,BRO
+stat,bline,kaufb101,1,3,1,4,1,2,1,0,0,1,0,0,0,1,0,0,1,-1,0,0
+stat,pline,seatt101,1,1,27,0,36,8,1,0,0,3,2,2,0,5,0,0,0,1,0
+stat,dline,kaufb101,1,1,3,27,9,1,0,1,0,0
+stat,tline,1,8,2,1,0
+stat,bline,lennp101,1,x,1,4,0,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0
+line,0,0,0,1,0,0,0,1,0,0
+line,1,1,0,0,2,0,0,0,0,x
+`
+
+func TestReadBoxScores(t *testing.T) {
+	convey.Convey("Given a box score file ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "BRO191404100"))
+		for _, p := range []string{"kaufb101", "seatt101", "kaufb101", "lennp101"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+		}
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		box, err := readers.ReadBoxScoresFromFile(sess, "1914BRO.EBN", strings.NewReader(boxScoreFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 1)
+		convey.So(pe[0].Field, convey.ShouldEqual, 4)
+
+		convey.So(len(box.Batting), convey.ShouldEqual, 1)
+		convey.So(box.Batting[0].Side, convey.ShouldEqual, models.HomeSide)
+		convey.So(box.Batting[0].BattingOrder, convey.ShouldEqual, 3)
+		convey.So(box.Batting[0].Hits, convey.ShouldEqual, 2)
+		convey.So(box.Batting[0].CaughtSteal, convey.ShouldEqual, -1)
+
+		convey.So(len(box.Pitching), convey.ShouldEqual, 1)
+		convey.So(box.Pitching[0].Outs, convey.ShouldEqual, 27)
+		convey.So(box.Pitching[0].EarnedRuns, convey.ShouldEqual, 2)
+		convey.So(box.Pitching[0].SacFlies, convey.ShouldEqual, 0)
+
+		convey.So(len(box.Fielding), convey.ShouldEqual, 1)
+		convey.So(box.Fielding[0].Position, convey.ShouldEqual, models.PositionFirstBase)
+		convey.So(box.Fielding[0].Putouts, convey.ShouldEqual, 9)
+
+		convey.So(len(box.LineScores), convey.ShouldEqual, 17)
+		convey.So(box.LineScores[2], convey.ShouldResemble, models.LineScore{
+			GameID: box.LineScores[2].GameID,
+			Side:   models.VisitingSide,
+			Inning: 3,
+			Runs:   1,
+		})
+	})
+}
+
+const missingBoxScoreFile = `id,BRO191404100
+stat,bline,kaufb101,1,3,1,4,1,2,1,0,0,1,0,0,0,1,0,0,1,-1,0,0
+line,0,0,0,1,0,0,0,1,0,0
+id
+stat,bline,kaufb101,1,3,1,4,1,2,1,0,0,1,0,0,0,1,0,0,1,-1,0,0
+id,BRO191404110
+stat,bline,kaufb101,1,3,1,4,1,2,1,0,0,1,0,0,0,1,0,0,1,-1,0,0
+stat,bline,zzzzz101,1,4,1,4,1,2,1,0,0,1,0,0,0,1,0,0,1,-1,0,0
+`
+
+func TestReadBoxScoresMissingGame(t *testing.T) {
+	convey.Convey("Given a box score file with a game that is not loaded ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(2, "BRO191404110"))
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, "kaufb101"))
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		box, err := readers.ReadBoxScoresFromFile(sess, "1914BRO.EBN", strings.NewReader(missingBoxScoreFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 3)
+		convey.So(pe[0].Line, convey.ShouldEqual, 1)
+		convey.So(pe[0].Reason, convey.ShouldEqual, "game not found")
+		convey.So(pe[1].Line, convey.ShouldEqual, 4)
+		convey.So(pe[1].Reason, convey.ShouldEqual, "too few fields")
+		convey.So(pe[2].Line, convey.ShouldEqual, 8)
+		convey.So(pe[2].Reason, convey.ShouldEqual, "player not found")
+
+		convey.So(len(box.LineScores), convey.ShouldEqual, 0)
+		convey.So(len(box.Batting), convey.ShouldEqual, 1)
+		convey.So(box.Batting[0].GameID, convey.ShouldEqual, 2)
+	})
+}
+
+func TestReadGamesFromBoxScores(t *testing.T) {
+	convey.Convey("Given an archive of box score files ...", t, func() {
+		dir, err := ioutil.TempDir("", "boxscores")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		name := filepath.Join(dir, "1914eb.zip")
+		out, err := os.Create(name)
+		convey.So(err, convey.ShouldBeNil)
+		w := zip.NewWriter(out)
+		f, err := w.Create("1914BRO.EBN")
+		convey.So(err, convey.ShouldBeNil)
+		_, err = f.Write([]byte(boxScoreFile + "event,wp,seatt101\n"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(w.Close(), convey.ShouldBeNil)
+		convey.So(out.Close(), convey.ShouldBeNil)
+
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		for _, team := range []string{"PIT", "BRO"} {
+			mock.ExpectQuery("SELECT (.+) FROM teams").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}).AddRow(1, team))
+		}
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		r, err := zip.OpenReader(name)
+		convey.So(err, convey.ShouldBeNil)
+		defer r.Close()
+
+		games, err := readers.ReadGames(sess, r)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(games), convey.ShouldEqual, 1)
+		convey.So(games[0].GameID, convey.ShouldEqual, "BRO191404100")
+	})
+}
@@ -0,0 +1,24 @@
+package readers
+
+import "strings"
+
+// parseComment returns the text of a com record. The text is normally
+// quoted, but older files have unquoted comments with commas in them.
+func parseComment(record []string) string {
+	if len(record) < 2 {
+		return ""
+	}
+	return strings.Join(record[1:], ",")
+}
+
+// appendComment adds text to comments. A com record that directly follows
+// another continues the same comment, so long comments split over several
+// lines, and $ notes such as ejections or replay reviews followed by their
+// details, stay together as one entry.
+func appendComment(comments []string, text string, continued bool) []string {
+	if continued && len(comments) > 0 {
+		comments[len(comments)-1] += " " + text
+		return comments
+	}
+	return append(comments, text)
+}
@@ -0,0 +1,17 @@
+package readers
+
+import "strconv"
+
+// parseEarnedRuns parses the runs of a data record,
+// data,er,<pitcher>,<earned runs>. On failure it returns the offending field
+// and the reason.
+func parseEarnedRuns(record []string) (int, int, string) {
+	if len(record) < 4 {
+		return 0, -1, "too few fields"
+	}
+	runs, err := strconv.Atoi(record[3])
+	if err != nil || runs < 0 {
+		return 0, 3, "invalid earned runs"
+	}
+	return runs, 0, ""
+}
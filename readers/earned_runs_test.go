@@ -0,0 +1,59 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const earnedRunEvents = `id,ANA201804020
+play,9,1,kinsi001,22,CBFBX,8/F
+data,er,ohtas001,3
+data,er,bedrc001,0
+data,er,parkb001,x
+data,er,zzzzz001,1
+`
+
+func TestReadEarnedRuns(t *testing.T) {
+	convey.Convey("Given data,er records ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "ANA201804020"))
+		for _, p := range []string{"kinsi001", "ohtas001", "bedrc001"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+		}
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(earnedRunEvents))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 2)
+		convey.So(pe[0].Line, convey.ShouldEqual, 5)
+		convey.So(pe[1].Line, convey.ShouldEqual, 6)
+		convey.So(pe[1].Reason, convey.ShouldEqual, "player not found")
+
+		convey.So(len(records.Events), convey.ShouldEqual, 3)
+		earnedRuns := records.EarnedRuns
+		convey.So(len(earnedRuns), convey.ShouldEqual, 2)
+		convey.So(earnedRuns[0].Runs, convey.ShouldEqual, 3)
+		convey.So(earnedRuns[1].Runs, convey.ShouldEqual, 0)
+	})
+}
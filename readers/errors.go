@@ -0,0 +1,86 @@
+package readers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// ParseError describes a record the readers could not turn into a model.
+// Line is 1-based; Field is the index of the offending field in the record,
+// or -1 when the whole record is at fault.
+type ParseError struct {
+	File       string
+	Line       int
+	RecordType string
+	Field      int
+	Text       string
+	Reason     string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d: %s record, field %d %q: %s",
+		e.File, e.Line, e.RecordType, e.Field, e.Text, e.Reason)
+}
+
+// ParseErrors collects the records a reader skipped. Readers return it
+// alongside everything they could read, so callers can log and carry on,
+// inspect each failure, or abort the load.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := []string{}
+	for _, pe := range e {
+		msgs = append(msgs, pe.Error())
+	}
+	return fmt.Sprintf("%d records failed to parse:\n%s", len(e), strings.Join(msgs, "\n"))
+}
+
+// err returns nil when nothing was collected so that a reader can always
+// end with return values, errs.err().
+func (e ParseErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func newParseError(file string, line int, recordType string, record []string, field int, reason string) *ParseError {
+	pe := &ParseError{
+		File:       file,
+		Line:       line,
+		RecordType: recordType,
+		Field:      field,
+		Reason:     reason,
+	}
+	if field >= 0 && field < len(record) {
+		pe.Text = record[field]
+	} else {
+		pe.Text = strings.Join(record, ",")
+	}
+	return pe
+}
+
+// isRecordError reports whether err, returned by a read, is a malformed
+// record that can be skipped. Any other error is a failure of the underlying
+// reader, such as a corrupt archive, that every later read would return
+// again.
+func isRecordError(err error) bool {
+	_, ok := err.(*csv.ParseError)
+	return ok
+}
+
+// csvLine returns the line on which the record last read by r, or the
+// record that failed with err, started. It is 0 when err is not a csv error,
+// such as a failed read of the archive, since r then has no record to
+// report a position for.
+func csvLine(r *csv.Reader, err error) int {
+	if pe, ok := err.(*csv.ParseError); ok {
+		return pe.StartLine
+	}
+	if err != nil {
+		return 0
+	}
+	line, _ := r.FieldPos(0)
+	return line
+}
@@ -4,84 +4,259 @@ import (
 	"archive/zip"
 	"fmt"
 	"io"
-	"log"
-	"path/filepath"
 
 	"github.com/gocraft/dbr"
 	"github.com/wazupwiddat/retrosheet/models"
 )
 
-func ReadGamesEvents(sess *dbr.Session, r *zip.ReadCloser) []models.GameEvent {
-	gameEvents := []models.GameEvent{}
+// EventRecords is everything read from the event files in a single pass:
+// the game events, the lineups built from their start and sub records and
+// the earned runs from their data,er records.
+type EventRecords struct {
+	Events      []models.GameEvent
+	Appearances []models.Appearance
+	EarnedRuns  []models.EarnedRuns
+}
+
+// ReadGamesEvents reads the events of every event file in the archive.
+// Records that fail to parse are skipped and returned as ParseErrors.
+func ReadGamesEvents(sess *dbr.Session, r *zip.ReadCloser) (EventRecords, error) {
+	records := EventRecords{}
+	errs := ParseErrors{}
 	for _, f := range r.File {
-		if filepath.Ext(f.Name) != ".EVA" && filepath.Ext(f.Name) != ".EVN" {
+		if !IsEventFile(f.Name) {
 			continue
 		}
 		fmt.Printf("Reading %s:\n", f.Name)
 		rc, err := f.Open()
 		if err != nil {
-			log.Fatal(err, f.Name)
+			return records, err
 		}
 
-		g := ReadGameEventsFromFile(sess, rc)
-		gameEvents = append(gameEvents, g...)
+		g, err := ReadGameEventsFromFile(sess, f.Name, rc)
+		rc.Close()
+		records.Events = append(records.Events, g.Events...)
+		records.Appearances = append(records.Appearances, g.Appearances...)
+		records.EarnedRuns = append(records.EarnedRuns, g.EarnedRuns...)
+		if pe, ok := err.(ParseErrors); ok {
+			errs = append(errs, pe...)
+		} else if err != nil {
+			return records, err
+		}
 	}
-	return gameEvents
+	return records, errs.err()
 }
 
-func ReadGameEventsFromFile(sess *dbr.Session, file io.ReadCloser) []models.GameEvent {
+// ReadGameEventsFromFile reads the start, sub, play, adjustment, com and
+// data records of a single event file, numbering each game's records in file
+// order so the game can be replayed. Each start and sub record is also an
+// Appearance that entered at its sequence, and each data,er record the
+// EarnedRuns of a pitcher. name is only used to report errors.
+func ReadGameEventsFromFile(sess *dbr.Session, name string, file io.Reader) (EventRecords, error) {
 	gameEvents := []models.GameEvent{}
-	// year := ParseYear(f.Name)
+	appearances := []models.Appearance{}
+	earnedRuns := []models.EarnedRuns{}
+	errs := ParseErrors{}
 	var game models.Game
-	var gameEvent models.GameEvent
+	var bases BaseState
+	var inning int
+	var half models.InningHalf
+	sequence := 0
+	// last is the index of the game's latest play in gameEvents, which
+	// the comments that follow it are attached to
+	last := -1
+	var lastType models.EventType = -1
+	// adjustments waiting for the play they apply to
+	pending := []models.Adjustment{}
+	// skip is set until an id record names a game that was loaded, so the
+	// records of a missing game are not saved under another one
+	skip := true
 	reader := NewGameReader(file)
 	for {
 		record, err := reader.Read()
 		if err == io.EOF {
-			gameEvents = append(gameEvents, gameEvent)
 			break
 		}
+		if err != nil && !isRecordError(err) {
+			return EventRecords{Events: gameEvents, Appearances: appearances, EarnedRuns: earnedRuns}, err
+		}
 		if err != nil {
-			log.Fatal(err)
+			errs = append(errs, newParseError(name, reader.Line(), "", record, -1, err.Error()))
+			continue
 		}
+		if len(record) == 0 {
+			continue
+		}
+
+		recordType, ok := ParseEventType(record[0])
+		if !ok {
+			errs = append(errs, newParseError(name, reader.Line(), record[0], record, 0, "unknown record type"))
+			continue
+		}
+		continued := lastType == models.Comment
+		lastType = recordType
 
-		if len(record) > 0 {
-			recordType, ok := ParseEventType(record[0])
-			if !ok {
-				log.Println("Missing EventType: ", record[0])
+		// records that only describe the game are not events
+		switch recordType {
+		case models.GameID:
+			bases.Reset()
+			inning, half = 0, models.TopHalf
+			sequence = 0
+			last = -1
+			pending = []models.Adjustment{}
+			if len(record) < 2 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, -1, "too few fields"))
+				skip = true
 				continue
 			}
-			switch recordType {
-			case models.GameID:
-				game, err = models.GetGame(sess, record[1])
-				if err != nil {
-					log.Println("Failed to Find game: ", record[1], err)
-					continue
+			game, err = models.GetGame(sess, record[1])
+			skip = err != nil || game.ID == 0
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, err.Error()))
+			} else if game.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, "game not found"))
+			}
+			continue
+		case models.Version, models.Info:
+			continue
+		}
+		if skip {
+			continue
+		}
+
+		gameEvent := models.NewGameEvent(game.ID, recordType, inning, half, 0)
+		gameEvent.Record = reader.Text()
+		// only plays have a count
+		gameEvent.Balls, gameEvent.Strikes = models.UnknownCount, models.UnknownCount
+		var appearance models.Appearance
+		switch recordType {
+		case models.Start, models.Sub:
+			var field int
+			var reason string
+			appearance, field, reason = parseAppearance(record)
+			if reason != "" {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, field, reason))
+				continue
+			}
+			player, err := models.GetPlayer(sess, record[1])
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, err.Error()))
+				continue
+			}
+			if player.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, "player not found"))
+				continue
+			}
+			gameEvent.Player = player.ID
+			appearance.GameID = game.ID
+			appearance.Player = player.ID
+		case models.Play:
+			if len(record) < 7 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, -1, "too few fields"))
+				continue
+			}
+			playInning := ParseInning(record[1])
+			playHalf, _ := ParseInningHalf(record[2])
+			player, err := models.GetPlayer(sess, record[3])
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 3, err.Error()))
+				continue
+			}
+			if player.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 3, "player not found"))
+				continue
+			}
+
+			gameEvent.Inning, gameEvent.InningHalf = playInning, playHalf
+			gameEvent.Player = player.ID
+			gameEvent.Balls, gameEvent.Strikes = ParseBallsStrikes(record[4])
+			gameEvent.Pitches = ParsePitches(record[5])
+			// Parse the actual events
+			eventDetail, err := ParseEventDetail(record[6])
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 6, err.Error()))
+				continue
+			}
+			if playInning != inning || playHalf != half {
+				bases.Reset()
+				inning, half = playInning, playHalf
+			}
+			for _, adj := range pending {
+				if adj.Type == models.RunnerAdj {
+					bases.Place(adj.Base)
 				}
-			case models.Play:
-				inning := ParseInning(record[1])
-				half, _ := ParseInningHalf(record[2])
-				player, err := models.GetPlayer(sess, record[3])
+			}
+			bases.Apply(&eventDetail)
+			gameEvent.Play = eventDetail
+			if len(pending) > 0 {
+				gameEvent.Adjustments = pending
+				pending = carryAdjustments(pending, eventDetail)
+			}
+		case models.BatterAdj, models.PitcherAdj, models.LineupAdj,
+			models.RunnerAdj, models.PitcherResponsibilityAdj:
+			adj, playerID, field, reason := parseAdjustment(recordType, record)
+			if reason != "" {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, field, reason))
+				continue
+			}
+			if playerID != "" {
+				player, err := models.GetPlayer(sess, playerID)
 				if err != nil {
-					log.Println("Failed to Find player: ", record[3], err)
+					errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, err.Error()))
 					continue
 				}
-				// cnt := ParseBallsStrikes(record[4])
-				// pitches := ParsePitches(record[5])
-
-				gameEvent := models.NewGameEvent(game.ID,
-					models.Play, inning, half, player.ID)
-				// Parse the actual events
-				eventDetail, ok := ParseEventDetail(record[6])
-				if !ok {
-					log.Println("Parse Event Detail failed: ", record[6])
+				if player.ID == 0 {
+					errs = append(errs, newParseError(name, reader.Line(), record[0], record, 1, "player not found"))
 					continue
 				}
-				gameEvent.Play = eventDetail
-				// log.Println(record, "\n", gameEvent)
-				gameEvents = append(gameEvents, gameEvent)
+				adj.Player = player.ID
+				gameEvent.Player = player.ID
+			}
+			pending = append(pending, adj)
+			gameEvent.Adjustments = []models.Adjustment{adj}
+		case models.Comment:
+			comment := parseComment(record)
+			gameEvent.Comments = []string{comment}
+			// comments before the first play belong to the game
+			if last >= 0 {
+				gameEvents[last].Comments = appendComment(gameEvents[last].Comments, comment, continued)
+			}
+		case models.Data:
+			if len(record) < 2 || record[1] != "er" {
+				break
+			}
+			runs, field, reason := parseEarnedRuns(record)
+			if reason != "" {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, field, reason))
+				continue
 			}
+			player, err := models.GetPlayer(sess, record[2])
+			if err != nil {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 2, err.Error()))
+				continue
+			}
+			if player.ID == 0 {
+				errs = append(errs, newParseError(name, reader.Line(), record[0], record, 2, "player not found"))
+				continue
+			}
+			gameEvent.Player = player.ID
+			earnedRuns = append(earnedRuns, models.EarnedRuns{
+				GameID: game.ID,
+				Player: player.ID,
+				Runs:   runs,
+			})
+		}
+
+		sequence++
+		gameEvent.Sequence = sequence
+		gameEvents = append(gameEvents, gameEvent)
+		switch recordType {
+		case models.Play:
+			last = len(gameEvents) - 1
+		case models.Start, models.Sub:
+			appearance.EntryEvent = sequence
+			appearances = append(appearances, appearance)
 		}
 	}
-	return gameEvents
+	return EventRecords{Events: gameEvents, Appearances: appearances, EarnedRuns: earnedRuns}, errs.err()
 }
@@ -2,6 +2,7 @@ package readers_test
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/gocraft/dbr"
@@ -9,6 +10,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
 	"github.com/wazupwiddat/retrosheet/readers"
 )
 
@@ -32,7 +34,239 @@ func TestReadEventFile(t *testing.T) {
 
 		sess := conn.NewSession(nil)
 
-		readers.ReadGameEventsFromFile(sess, r)
+		_, err = readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", r)
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
 
 	})
 }
+
+func playEvents(events []models.GameEvent) []models.GameEvent {
+	plays := []models.GameEvent{}
+	for _, e := range events {
+		if e.Event == models.Play {
+			plays = append(plays, e)
+		}
+	}
+	return plays
+}
+
+const commentEvents = `id,ANA201804020
+com,"Game delayed 20 minutes by rain"
+play,1,0,naqut001,12,FSBT,K
+com,"Naquin left the game in the top of the first"
+com,"with a strained hamstring"
+play,1,0,zimmb001,00,X,S3/G
+com,"$Ejection: Francona ejected by umpire Cuzzi, arguing balls and strikes"
+`
+
+func TestReadEventComments(t *testing.T) {
+	convey.Convey("Given an event file with comments ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "ANA201804020"))
+		for _, p := range []string{"naqut001", "zimmb001"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+		}
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(commentEvents))
+		all := records.Events
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(all), convey.ShouldEqual, 6)
+		for i, e := range all {
+			convey.So(e.Sequence, convey.ShouldEqual, i+1)
+		}
+		convey.So(all[0].Event, convey.ShouldEqual, models.Comment)
+		convey.So(all[0].Record, convey.ShouldEqual, `com,"Game delayed 20 minutes by rain"`)
+		convey.So(all[0].Balls, convey.ShouldEqual, models.UnknownCount)
+		convey.So(all[0].Strikes, convey.ShouldEqual, models.UnknownCount)
+		convey.So(all[1].Balls, convey.ShouldEqual, 1)
+
+		events := playEvents(all)
+		convey.So(events[0].Comments, convey.ShouldResemble, []string{
+			"Naquin left the game in the top of the first with a strained hamstring",
+		})
+		convey.So(events[1].Comments, convey.ShouldResemble, []string{
+			"$Ejection: Francona ejected by umpire Cuzzi, arguing balls and strikes",
+		})
+	})
+}
+
+const adjustmentEvents = `id,ANA201804020
+radj,troum001,2
+play,10,0,naqut001,00,X,S8
+badj,zimmb001,L
+play,10,0,zimmb001,00,B,SB3
+play,10,0,zimmb001,10,BX,W
+play,10,0,lindf001,00,X,8/F
+`
+
+func TestReadEventAdjustments(t *testing.T) {
+	convey.Convey("Given an event file with adjustments ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "ANA201804020"))
+		for _, p := range []string{"troum001", "naqut001", "zimmb001", "zimmb001", "zimmb001", "lindf001"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+		}
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(adjustmentEvents))
+		all := records.Events
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(all[0].Event, convey.ShouldEqual, models.RunnerAdj)
+
+		events := playEvents(all)
+
+		convey.So(len(events[0].Adjustments), convey.ShouldEqual, 1)
+		convey.So(events[0].Adjustments[0].Type, convey.ShouldEqual, models.RunnerAdj)
+		convey.So(events[0].Adjustments[0].Base, convey.ShouldEqual, 2)
+
+		for _, e := range events[1:3] {
+			convey.So(len(e.Adjustments), convey.ShouldEqual, 1)
+			convey.So(e.Adjustments[0].Type, convey.ShouldEqual, models.BatterAdj)
+			convey.So(e.Adjustments[0].Hand, convey.ShouldEqual, models.LeftHanded)
+		}
+		convey.So(events[3].Adjustments, convey.ShouldBeEmpty)
+
+		// the placed runner on second stole third, so the walk forces
+		// only the runner on first
+		convey.So(events[2].Play.RunnerAdv, convey.ShouldResemble, []models.RunnerAdvance{
+			{StartBase: 0, FinishBase: 1, Implied: true},
+			{StartBase: 1, FinishBase: 2, Implied: true},
+		})
+	})
+}
+
+const unknownAdjustmentEvents = `id,ANA201804020
+radj,zzzzz001,2
+play,10,0,naqut001,00,X,S8
+`
+
+func TestReadEventUnknownAdjustment(t *testing.T) {
+	convey.Convey("Given an adjustment for a player that is not loaded ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "ANA201804020"))
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, "naqut001"))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(unknownAdjustmentEvents))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 1)
+		convey.So(pe[0].Line, convey.ShouldEqual, 2)
+		convey.So(pe[0].Reason, convey.ShouldEqual, "player not found")
+
+		convey.So(len(records.Events), convey.ShouldEqual, 1)
+		convey.So(records.Events[0].Event, convey.ShouldEqual, models.Play)
+		convey.So(len(records.Events[0].Adjustments), convey.ShouldEqual, 0)
+	})
+}
+
+const missingGameEvents = `id,ANA201804020
+start,troum001,"Mike Trout",1,2,8
+play,1,1,troum001,00,X,S8
+id,ANA201804030
+start,troum001,"Mike Trout",1,2,8
+play,1,1,troum001,00,X,HR/F78
+play,1,1,zzzzz001,00,X,S8
+id
+play,1,1,troum001,00,X,S8
+`
+
+func TestReadEventsMissingGame(t *testing.T) {
+	convey.Convey("Given an event file with a game that was not loaded ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(2, "ANA201804030"))
+		for i := 0; i < 2; i++ {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, "troum001"))
+		}
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(missingGameEvents))
+		all := records.Events
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 3)
+		convey.So(pe[0].Line, convey.ShouldEqual, 1)
+		convey.So(pe[0].Reason, convey.ShouldEqual, "game not found")
+		convey.So(pe[1].Line, convey.ShouldEqual, 7)
+		convey.So(pe[1].Reason, convey.ShouldEqual, "player not found")
+		convey.So(pe[2].Line, convey.ShouldEqual, 8)
+		convey.So(pe[2].Reason, convey.ShouldEqual, "too few fields")
+
+		convey.So(len(all), convey.ShouldEqual, 2)
+		for _, e := range all {
+			convey.So(e.GameID, convey.ShouldEqual, 2)
+		}
+		convey.So(all[0].Sequence, convey.ShouldEqual, 1)
+	})
+}
+
+func TestReadEventsReadFailure(t *testing.T) {
+	convey.Convey("Given an event file that fails to read ...", t, func() {
+		db, _, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", failingReader{})
+		convey.So(err, convey.ShouldNotHaveSameTypeAs, readers.ParseErrors{})
+		convey.So(err.Error(), convey.ShouldEqual, "zip: checksum error")
+		convey.So(len(records.Events), convey.ShouldEqual, 0)
+	})
+}
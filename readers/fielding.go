@@ -0,0 +1,151 @@
+package readers
+
+import (
+	"strings"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// parseFieldingSequence credits a single fielding sequence such as 643, 2E4
+// or E8. The last fielder makes the putout and the others get assists,
+// unless an error ends the sequence, in which case the erring fielder is
+// charged and nobody makes the putout. A fielder who handles the ball more
+// than once, as in a rundown, gets only one assist.
+func parseFieldingSequence(seq string) []models.FieldingCredit {
+	credits := []models.FieldingCredit{}
+	assisted := map[models.Position]bool{}
+	assist := func(f models.Position) {
+		if !assisted[f] {
+			assisted[f] = true
+			credits = append(credits, models.FieldingCredit{Position: f, Credit: models.CreditAssist})
+		}
+	}
+
+	fielders := []models.Position{}
+	for i := 0; i < len(seq); i++ {
+		c := seq[i]
+		if c == 'E' {
+			for _, f := range fielders {
+				assist(f)
+			}
+			if i+1 < len(seq) {
+				credits = append(credits, models.FieldingCredit{Position: positionMap[string(seq[i+1])], Credit: models.CreditError})
+			}
+			return credits
+		}
+		if pos, ok := positionMap[string(c)]; ok {
+			fielders = append(fielders, pos)
+		}
+	}
+	if len(fielders) == 0 {
+		return credits
+	}
+	for _, f := range fielders[:len(fielders)-1] {
+		assist(f)
+	}
+	credits = append(credits, models.FieldingCredit{Position: fielders[len(fielders)-1], Credit: models.CreditPutout})
+	return credits
+}
+
+// parseOutSegments credits fielding plays made up of several outs such as
+// 64(1)3 or 1(B)16(2)63(1). Each parenthesized runner closes a segment; a
+// segment that does not start with the fielder who ended the previous one
+// means that fielder threw the ball and gets an assist.
+func parseOutSegments(code string) []models.FieldingCredit {
+	credits := []models.FieldingCredit{}
+	segments := []string{}
+	var segment strings.Builder
+	inRunner := false
+	for _, c := range code {
+		switch {
+		case c == '(':
+			inRunner = true
+			segments = append(segments, segment.String())
+			segment.Reset()
+		case c == ')':
+			inRunner = false
+		case !inRunner:
+			segment.WriteRune(c)
+		}
+	}
+	if segment.Len() > 0 {
+		segments = append(segments, segment.String())
+	}
+
+	last := byte(0)
+	for _, seg := range segments {
+		if seg == "" {
+			continue
+		}
+		if last != 0 && seg[0] != last {
+			seg = string(last) + seg
+		}
+		credits = append(credits, parseFieldingSequence(seg)...)
+		last = seg[len(seg)-1]
+	}
+	return credits
+}
+
+// parseEventCredits credits the fielders involved in a single event.
+// batterOut is false when the batter reached base on the play, as on a
+// strikeout with a wild pitch.
+func parseEventCredits(ev EventNode, play models.BasicPlay, batterOut bool) []models.FieldingCredit {
+	switch play {
+	case models.FlyBallOut, models.GroundBallOut, models.GroundedIntoDoublePlay,
+		models.LinedIntoDoublePlay, models.LinedIntoTriplePlay:
+		return parseOutSegments(ev.Code)
+	case models.StrikeOut:
+		if !batterOut {
+			return []models.FieldingCredit{}
+		}
+		if seq := digitsRegexp.FindString(ev.Code); seq != "" {
+			return parseFieldingSequence(seq)
+		}
+		return []models.FieldingCredit{{Position: models.PositionCatcher, Credit: models.CreditPutout}}
+	case models.Error, models.ErrorOnFlyBall:
+		return parseFieldingSequence("E" + digitsRegexp.FindString(ev.Code))
+	case models.CaughtStealing, models.PickOff, models.PickOffCaughtStealing:
+		if len(ev.Groups) == 0 {
+			return []models.FieldingCredit{}
+		}
+		return parseFieldingSequence(strings.Split(ev.Groups[0], "/")[0])
+	}
+	return []models.FieldingCredit{}
+}
+
+// parseCredits collects the fielding credits of a whole play: its events,
+// errors given as modifiers (C/E2) and the fielding on runner advances.
+func parseCredits(node PlayNode, ed models.EventDetail) []models.FieldingCredit {
+	batterOut := true
+	for _, ra := range ed.RunnerAdv {
+		if ra.StartBase == 0 && !ra.Out {
+			batterOut = false
+		}
+	}
+
+	credits := []models.FieldingCredit{}
+	for i, ev := range node.Primary {
+		play := ed.Play
+		if i > 0 {
+			play = ParseBasicPlay(ev.Code)
+		}
+		credits = append(credits, parseEventCredits(ev, play, batterOut)...)
+	}
+	for i, ev := range node.Secondary {
+		credits = append(credits, parseEventCredits(ev, ed.ExtraPlays[i], batterOut)...)
+	}
+	for _, m := range node.Modifiers {
+		if e := errorOnRegexp.FindStringSubmatch(m.Code); e != nil {
+			credits = append(credits, models.FieldingCredit{Position: positionMap[e[1]], Credit: models.CreditError})
+		}
+	}
+	for _, adv := range node.Advances {
+		for _, detail := range adv.Details {
+			seq := strings.Split(detail, "/")[0]
+			if fieldingRegexp.MatchString(seq) {
+				credits = append(credits, parseFieldingSequence(seq)...)
+			}
+		}
+	}
+	return credits
+}
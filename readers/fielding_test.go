@@ -0,0 +1,106 @@
+package readers_test
+
+import (
+	"testing"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+func TestFieldingCredits(t *testing.T) {
+	convey.Convey("Given plays with fielding...", t, func() {
+		po := func(p models.Position) models.FieldingCredit {
+			return models.FieldingCredit{Position: p, Credit: models.CreditPutout}
+		}
+		a := func(p models.Position) models.FieldingCredit {
+			return models.FieldingCredit{Position: p, Credit: models.CreditAssist}
+		}
+		e := func(p models.Position) models.FieldingCredit {
+			return models.FieldingCredit{Position: p, Credit: models.CreditError}
+		}
+		tests := []struct {
+			t string
+			v []models.FieldingCredit
+		}{
+			{
+				"64(1)3/GDP/G6",
+				[]models.FieldingCredit{
+					a(models.PositionShortStop),
+					po(models.PositionSecondBase),
+					a(models.PositionSecondBase),
+					po(models.PositionFirstBase),
+				},
+			},
+			{
+				"6(1)3/GDP",
+				[]models.FieldingCredit{
+					po(models.PositionShortStop),
+					a(models.PositionShortStop),
+					po(models.PositionFirstBase),
+				},
+			},
+			{
+				"CS2(2E4).1-3",
+				[]models.FieldingCredit{
+					a(models.PositionCatcher),
+					e(models.PositionSecondBase),
+				},
+			},
+			{
+				"PO1(16343)",
+				[]models.FieldingCredit{
+					a(models.PositionPitcher),
+					a(models.PositionShortStop),
+					a(models.PositionFirstBase),
+					a(models.PositionSecondBase),
+					po(models.PositionFirstBase),
+				},
+			},
+			{
+				"K+CS2(26)/DP",
+				[]models.FieldingCredit{
+					po(models.PositionCatcher),
+					a(models.PositionCatcher),
+					po(models.PositionShortStop),
+				},
+			},
+			{
+				"K+WP.B-1",
+				[]models.FieldingCredit{},
+			},
+			{
+				"FC5/G.3XH(52);B-1",
+				[]models.FieldingCredit{
+					a(models.PositionThirdBase),
+					po(models.PositionCatcher),
+				},
+			},
+			{
+				"E5/TH/G5.BX2(7E4)",
+				[]models.FieldingCredit{
+					e(models.PositionThirdBase),
+					a(models.PositionLeftField),
+					e(models.PositionSecondBase),
+				},
+			},
+			{
+				"C/E2.1-2",
+				[]models.FieldingCredit{
+					e(models.PositionCatcher),
+				},
+			},
+			{
+				"S8.2-H;1-3",
+				[]models.FieldingCredit{},
+			},
+		}
+		for _, test := range tests {
+			convey.Convey("Credit "+test.t+"...", func() {
+				ed, err := readers.ParseEventDetail(test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ed.Credits, convey.ShouldResemble, test.v)
+			})
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package readers
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// FranchiseFile is Retrosheet's list of team abbreviations by franchise,
+// which is not part of the yearly event archives.
+const FranchiseFile = "CurrentNames.csv"
+
+// ReadFranchises reads the franchise file,
+// <franchise>,<team>,<league>,<division>,<location>,<nickname>,<alt nickname>,<first game>,<last game>,<city>,<state>.
+// Records that fail to parse are skipped and returned as ParseErrors.
+func ReadFranchises(r io.Reader) ([]models.Franchise, error) {
+	franchises := []models.Franchise{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return franchises, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(FranchiseFile, line, "franchise", record, -1, err.Error()))
+			continue
+		}
+		if len(record) < 11 {
+			errs = append(errs, newParseError(FranchiseFile, line, "franchise", record, -1, "too few fields"))
+			continue
+		}
+		f := models.Franchise{
+			FranchiseID: record[0],
+			TeamCode:    record[1],
+			League:      ParseLeague(record[2]),
+			Division:    record[3],
+			Location:    record[4],
+			Nickname:    record[5],
+			AltNickname: record[6],
+			City:        record[9],
+			State:       record[10],
+		}
+		if f.League < 0 {
+			errs = append(errs, newParseError(FranchiseFile, line, "franchise", record, 2, "unknown league"))
+			continue
+		}
+		f.FirstGame, err = time.Parse("1/2/2006", record[7])
+		if err != nil {
+			errs = append(errs, newParseError(FranchiseFile, line, "franchise", record, 7, err.Error()))
+			continue
+		}
+		if record[8] != "" {
+			last, err := time.Parse("1/2/2006", record[8])
+			if err != nil {
+				errs = append(errs, newParseError(FranchiseFile, line, "franchise", record, 8, err.Error()))
+				continue
+			}
+			f.LastGame.Time, f.LastGame.Valid = last, true
+		}
+		franchises = append(franchises, f)
+	}
+	return franchises, errs.err()
+}
@@ -0,0 +1,56 @@
+package readers_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const franchiseFile = `ANA,LAA,AL,,Los Angeles,Angels,,4/11/1961,9/1/1965,Los Angeles,CA
+ANA,CAL,AL,,California,Angels,,9/2/1965,4/2/1997,Anaheim,CA
+ANA,ANA,AL,,Anaheim,Angels,,4/3/1997,12/31/2004,Anaheim,CA
+ANA,ANA,AL,W,Los Angeles of Anaheim,Angels,,1/1/2005,,Anaheim,CA
+BRF,BRF,XX,,Brooklyn,Tip-Tops,,4/10/1914,10/3/1915,New York,NY
+`
+
+func TestReadFranchises(t *testing.T) {
+	convey.Convey("Given a franchise file ...", t, func() {
+		franchises, err := readers.ReadFranchises(strings.NewReader(franchiseFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		convey.So(err.(readers.ParseErrors)[0].Line, convey.ShouldEqual, 5)
+
+		convey.So(len(franchises), convey.ShouldEqual, 4)
+		for _, f := range franchises {
+			convey.So(f.FranchiseID, convey.ShouldEqual, "ANA")
+			convey.So(f.League, convey.ShouldEqual, models.American)
+			convey.So(f.Nickname, convey.ShouldEqual, "Angels")
+		}
+		convey.So(franchises[1].TeamCode, convey.ShouldEqual, "CAL")
+		convey.So(franchises[1].Location, convey.ShouldEqual, "California")
+		convey.So(franchises[1].FirstGame, convey.ShouldEqual, time.Date(1965, 9, 2, 0, 0, 0, 0, time.UTC))
+		convey.So(franchises[1].LastGame.Valid, convey.ShouldBeTrue)
+		convey.So(franchises[3].LastGame.Valid, convey.ShouldBeFalse)
+		convey.So(franchises[3].Division, convey.ShouldEqual, "W")
+	})
+}
+
+// failingReader fails every read, as a corrupt archive member does.
+type failingReader struct{}
+
+func (r failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("zip: checksum error")
+}
+
+func TestReadFranchisesReadFailure(t *testing.T) {
+	convey.Convey("Given a file that fails to read ...", t, func() {
+		franchises, err := readers.ReadFranchises(failingReader{})
+		convey.So(err, convey.ShouldNotHaveSameTypeAs, readers.ParseErrors{})
+		convey.So(err.Error(), convey.ShouldEqual, "zip: checksum error")
+		convey.So(len(franchises), convey.ShouldEqual, 0)
+	})
+}
@@ -4,12 +4,13 @@ import (
 	"bufio"
 	"encoding/csv"
 	"io"
-	"log"
 	"strings"
 )
 
 type GameReader struct {
 	scanner *bufio.Scanner
+	line    int
+	text    string
 }
 
 func NewGameReader(r io.Reader) GameReader {
@@ -19,17 +20,31 @@ func NewGameReader(r io.Reader) GameReader {
 	return gr
 }
 
+// Line returns the 1-based line number of the record last returned by Read.
+func (gr *GameReader) Line() int {
+	return gr.line
+}
+
+// Text returns the unparsed line of the record last returned by Read.
+func (gr *GameReader) Text() string {
+	return gr.text
+}
+
 func (gr *GameReader) Read() ([]string, error) {
 	ok := gr.scanner.Scan()
 	if !ok {
+		if err := gr.scanner.Err(); err != nil {
+			return nil, err
+		}
 		return nil, io.EOF
 	}
+	gr.line++
 	t := gr.scanner.Text()
+	gr.text = t
+	if strings.TrimSpace(t) == "" {
+		return []string{}, nil
+	}
 	r := csv.NewReader(strings.NewReader(t))
 	r.LazyQuotes = true
-	fields, err := r.Read()
-	if err != nil {
-		log.Println("Line read failed: ", t)
-	}
-	return fields, err
+	return r.Read()
 }
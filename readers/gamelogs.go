@@ -0,0 +1,226 @@
+package readers
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// gameLogFields is the number of fields in every game log record.
+const gameLogFields = 161
+
+var (
+	gameLogNumberMap = map[string]int{
+		"0": 0,
+		"1": 1,
+		"2": 2,
+		"3": 3,
+		// doubleheaders that involve a Negro League team
+		"A": 1,
+		"B": 2,
+	}
+	gameLogDayNightMap = map[string]models.DayNight{
+		"":  models.DayNightUnknown,
+		"D": models.Day,
+		"N": models.Night,
+	}
+)
+
+// IsGameLogFile reports whether name is a game log, GLyyyy.TXT.
+func IsGameLogFile(name string) bool {
+	base := strings.ToUpper(filepath.Base(name))
+	return strings.HasPrefix(base, "GL") && filepath.Ext(base) == ".TXT"
+}
+
+// ReadGameLogs reads every game log in the archive. Records that fail to
+// parse are skipped and returned as ParseErrors.
+func ReadGameLogs(r *zip.ReadCloser) ([]models.GameLog, error) {
+	logs := []models.GameLog{}
+	errs := ParseErrors{}
+	for _, f := range r.File {
+		if !IsGameLogFile(f.Name) {
+			continue
+		}
+		fmt.Printf("Reading %s:\n", f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			return logs, err
+		}
+
+		l, err := ReadGameLogsFromFile(f.Name, rc)
+		rc.Close()
+		logs = append(logs, l...)
+		if pe, ok := err.(ParseErrors); ok {
+			errs = append(errs, pe...)
+		} else if err != nil {
+			return logs, err
+		}
+	}
+	return logs, errs.err()
+}
+
+// ReadGameLogsFromFile reads a single game log, one 161 field record per
+// game; name is only used to report errors.
+func ReadGameLogsFromFile(name string, file io.Reader) ([]models.GameLog, error) {
+	logs := []models.GameLog{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return logs, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(name, line, "gamelog", record, -1, err.Error()))
+			continue
+		}
+		gl, field, reason := parseGameLog(record)
+		if reason != "" {
+			errs = append(errs, newParseError(name, line, "gamelog", record, field, reason))
+			continue
+		}
+		logs = append(logs, gl)
+	}
+	return logs, errs.err()
+}
+
+// parseGameLog turns a game log record into a GameLog. On failure it
+// returns the offending field and the reason.
+func parseGameLog(record []string) (models.GameLog, int, string) {
+	if len(record) < gameLogFields {
+		return models.GameLog{}, -1, "too few fields"
+	}
+	gl := models.GameLog{
+		DayOfWeek:        record[2],
+		Visitor:          record[3],
+		Home:             record[6],
+		Completion:       record[13],
+		Forfeit:          record[14],
+		Protest:          record[15],
+		Site:             record[16],
+		VisitorLineScore: record[19],
+		HomeLineScore:    record[20],
+		AdditionalInfo:   record[159],
+		Acquisition:      record[160],
+	}
+
+	var err error
+	gl.Played, err = time.Parse("20060102", record[0])
+	if err != nil {
+		return gl, 0, err.Error()
+	}
+	var ok bool
+	if gl.GameNumber, ok = gameLogNumberMap[record[1]]; !ok {
+		return gl, 1, "unknown game number"
+	}
+	if gl.VisitorLeague = ParseLeague(record[4]); gl.VisitorLeague < 0 {
+		return gl, 4, "unknown league"
+	}
+	if gl.HomeLeague = ParseLeague(record[7]); gl.HomeLeague < 0 {
+		return gl, 7, "unknown league"
+	}
+	if gl.DayNight, ok = gameLogDayNightMap[record[12]]; !ok {
+		return gl, 12, "unknown day or night"
+	}
+
+	numbers := []struct {
+		start int
+		vals  []*int
+	}{
+		{5, []*int{&gl.VisitorGameNumber}},
+		{8, []*int{&gl.HomeGameNumber, &gl.VisitorScore, &gl.HomeScore, &gl.Outs}},
+		{17, []*int{&gl.Attendance, &gl.TimeOfGame}},
+		{21, teamTotals(&gl.VisitorTotals)},
+		{49, teamTotals(&gl.HomeTotals)},
+	}
+	for _, n := range numbers {
+		if field, reason := parseGameLogNumbers(record, n.start, n.vals...); reason != "" {
+			return gl, field, reason
+		}
+	}
+
+	people := []*models.GameLogPerson{
+		&gl.UmpireHome, &gl.UmpireFirst, &gl.UmpireSecond, &gl.UmpireThird,
+		&gl.UmpireLeft, &gl.UmpireRight, &gl.VisitorManager, &gl.HomeManager,
+		&gl.WinningPitcher, &gl.LosingPitcher, &gl.SavePitcher,
+		&gl.GameWinningRBI, &gl.VisitorPitcher, &gl.HomePitcher,
+	}
+	for i, p := range people {
+		p.ID, p.Name = parseGameLogPerson(record[77+2*i], record[78+2*i])
+	}
+
+	lineups := []struct {
+		start  int
+		lineup *[9]models.GameLogStarter
+	}{
+		{105, &gl.VisitorLineup},
+		{132, &gl.HomeLineup},
+	}
+	for _, l := range lineups {
+		for i := range l.lineup {
+			field := l.start + 3*i
+			s := &l.lineup[i]
+			s.ID, s.Name = parseGameLogPerson(record[field], record[field+1])
+			if record[field+2] == "" {
+				continue
+			}
+			if s.Position, ok = positionMap[record[field+2]]; !ok {
+				return gl, field + 2, "unknown position"
+			}
+		}
+	}
+	return gl, 0, ""
+}
+
+// teamTotals lists the fields of t in game log order.
+func teamTotals(t *models.TeamTotals) []*int {
+	return []*int{
+		&t.AtBats, &t.Hits, &t.Doubles, &t.Triples, &t.HomeRuns, &t.RBI,
+		&t.SacHits, &t.SacFlies, &t.HitByPitch, &t.Walks, &t.IntWalks,
+		&t.StrikeOuts, &t.StolenBases, &t.CaughtSteal, &t.GIDP,
+		&t.Interference, &t.LeftOnBase,
+		&t.PitchersUsed, &t.EarnedRuns, &t.TeamEarnedRuns, &t.WildPitches,
+		&t.Balks,
+		&t.Putouts, &t.Assists, &t.Errors, &t.PassedBalls, &t.DoublePlays,
+		&t.TriplePlays,
+	}
+}
+
+// parseGameLogNumbers parses the numeric fields of record from start on
+// into vals, in order, like parseStats. Blank fields are unknown and
+// become -1.
+func parseGameLogNumbers(record []string, start int, vals ...*int) (int, string) {
+	for i, val := range vals {
+		if record[start+i] == "" {
+			*val = -1
+			continue
+		}
+		v, err := strconv.Atoi(record[start+i])
+		if err != nil {
+			return start + i, "not a number"
+		}
+		*val = v
+	}
+	return 0, ""
+}
+
+// parseGameLogPerson returns the id and name of a person, which the game
+// logs give as (none) when there was nobody, such as no save.
+func parseGameLogPerson(id, name string) (string, string) {
+	if strings.EqualFold(id, "(none)") {
+		return "", ""
+	}
+	return id, name
+}
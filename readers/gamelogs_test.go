@@ -0,0 +1,62 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const gameLogFile = `"20180329",0,"Thu","COL","NL",1,"ARI","NL",1,2,8,51,"D","","","","PHO01",49016,185,"000100100","00040400x",33,6,1,0,1,2,0,0,0,2,0,10,0,0,1,0,5,5,8,8,0,0,24,8,0,0,1,0,31,9,2,0,3,8,0,0,1,4,0,8,1,0,0,0,6,4,2,2,0,0,27,9,1,0,0,0,"barrl901","Lance Barrett","hoyej901","James Hoye","tumpj901","John Tumpane","drakr901","Rob Drake","(none)","(none)","(none)","(none)","blacb001","Bud Black","lovot001","Torey Lovullo","corbp001","Patrick Corbin","andet002","Tyler Anderson","(none)","(none)","goldp001","Paul Goldschmidt","andet002","Tyler Anderson","corbp001","Patrick Corbin","colv00","Visitor 0",1,"colv01","Visitor 1",2,"colv02","Visitor 2",3,"colv03","Visitor 3",4,"colv04","Visitor 4",5,"colv05","Visitor 5",6,"colv06","Visitor 6",7,"colv07","Visitor 7",8,"colv08","Visitor 8",1,"home00","Home 0",1,"home01","Home 1",2,"home02","Home 2",3,"home03","Home 3",4,"home04","Home 4",5,"home05","Home 5",6,"home06","Home 6",7,"home07","Home 7",8,"home08","Home 8",9,"","Y"
+"20180330",0,"Fri","COL","NL",2,"ARI","NL",2
+"20180329",0,"Thu","COL","XL",1,"ARI","NL",1,2,8,51,"D","","","","PHO01",49016,185,"000100100","00040400x",33,6,1,0,1,2,0,0,0,2,0,10,0,0,1,0,5,5,8,8,0,0,24,8,0,0,1,0,31,9,2,0,3,8,0,0,1,4,0,8,1,0,0,0,6,4,2,2,0,0,27,9,1,0,0,0,"barrl901","Lance Barrett","hoyej901","James Hoye","tumpj901","John Tumpane","drakr901","Rob Drake","(none)","(none)","(none)","(none)","blacb001","Bud Black","lovot001","Torey Lovullo","corbp001","Patrick Corbin","andet002","Tyler Anderson","(none)","(none)","goldp001","Paul Goldschmidt","andet002","Tyler Anderson","corbp001","Patrick Corbin","colv00","Visitor 0",1,"colv01","Visitor 1",2,"colv02","Visitor 2",3,"colv03","Visitor 3",4,"colv04","Visitor 4",5,"colv05","Visitor 5",6,"colv06","Visitor 6",7,"colv07","Visitor 7",8,"colv08","Visitor 8",1,"home00","Home 0",1,"home01","Home 1",2,"home02","Home 2",3,"home03","Home 3",4,"home04","Home 4",5,"home05","Home 5",6,"home06","Home 6",7,"home07","Home 7",8,"home08","Home 8",9,"","Y"
+`
+
+func TestReadGameLogs(t *testing.T) {
+	convey.Convey("Given a game log ...", t, func() {
+		logs, err := readers.ReadGameLogsFromFile("GL2018.TXT", strings.NewReader(gameLogFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 2)
+		convey.So(pe[0].Line, convey.ShouldEqual, 2)
+		convey.So(pe[0].Field, convey.ShouldEqual, -1)
+		convey.So(pe[1].Field, convey.ShouldEqual, 4)
+
+		convey.So(len(logs), convey.ShouldEqual, 1)
+		gl := logs[0]
+		convey.So(gl.GameID(), convey.ShouldEqual, "ARI201803290")
+		convey.So(gl.VisitorLeague, convey.ShouldEqual, models.National)
+		convey.So(gl.VisitorScore, convey.ShouldEqual, 2)
+		convey.So(gl.HomeScore, convey.ShouldEqual, 8)
+		convey.So(gl.Outs, convey.ShouldEqual, 51)
+		convey.So(gl.DayNight, convey.ShouldEqual, models.Day)
+		convey.So(gl.Site, convey.ShouldEqual, "PHO01")
+		convey.So(gl.Attendance, convey.ShouldEqual, 49016)
+		convey.So(gl.HomeLineScore, convey.ShouldEqual, "00040400x")
+		convey.So(gl.VisitorTotals.StrikeOuts, convey.ShouldEqual, 10)
+		convey.So(gl.VisitorTotals.LeftOnBase, convey.ShouldEqual, 5)
+		convey.So(gl.HomeTotals.HomeRuns, convey.ShouldEqual, 3)
+		convey.So(gl.HomeTotals.Putouts, convey.ShouldEqual, 27)
+		convey.So(gl.UmpireThird.ID, convey.ShouldEqual, "drakr901")
+		convey.So(gl.UmpireLeft, convey.ShouldResemble, models.GameLogPerson{})
+		convey.So(gl.SavePitcher.ID, convey.ShouldEqual, "")
+		convey.So(gl.WinningPitcher.Name, convey.ShouldEqual, "Patrick Corbin")
+		convey.So(gl.VisitorLineup[8].Position, convey.ShouldEqual, models.PositionPitcher)
+		convey.So(gl.HomeLineup[0].ID, convey.ShouldEqual, "home00")
+		convey.So(gl.Acquisition, convey.ShouldEqual, "Y")
+
+		convey.Convey("Enrich a game from the event files ...", func() {
+			game := models.NewGame(gl.GameID())
+			game.Site = "PHO01"
+			game.Attendance = 49000
+			gl.Enrich(&game)
+			convey.So(game.VisitorScore, convey.ShouldEqual, 2)
+			convey.So(game.HomeScore, convey.ShouldEqual, 8)
+			convey.So(game.Attendance, convey.ShouldEqual, 49000)
+			convey.So(game.TimeOfGame, convey.ShouldEqual, 185)
+			convey.So(game.UmpireHome, convey.ShouldEqual, "barrl901")
+		})
+	})
+}
@@ -4,83 +4,147 @@ import (
 	"archive/zip"
 	"fmt"
 	"io"
-	"log"
-	"path/filepath"
 	"time"
 
 	"github.com/gocraft/dbr"
 	"github.com/wazupwiddat/retrosheet/models"
 )
 
-func ReadGames(sess *dbr.Session, r *zip.ReadCloser) []models.Game {
+// ReadGames reads the games in every event file in the archive. Records that
+// fail to parse are skipped and returned as ParseErrors.
+func ReadGames(sess *dbr.Session, r *zip.ReadCloser) ([]models.Game, error) {
 	games := []models.Game{}
+	errs := ParseErrors{}
 	for _, f := range r.File {
-		if filepath.Ext(f.Name) != ".EVA" && filepath.Ext(f.Name) != ".EVN" {
+		if !IsEventFile(f.Name) && !IsBoxScoreFile(f.Name) {
 			continue
 		}
 		fmt.Printf("Reading %s:\n", f.Name)
+		year, err := ParseYear(f.Name)
+		if err != nil {
+			errs = append(errs, newParseError(f.Name, 0, "", nil, -1, err.Error()))
+			continue
+		}
 		rc, err := f.Open()
 		if err != nil {
-			log.Fatal(err, f.Name)
+			return games, err
 		}
 
-		year := ParseYear(f.Name)
 		var game models.Game
+		var lastType models.EventType = -1
+		played := false
+		// records are skipped until the next id after a truncated one
+		skip := false
 		reader := NewGameReader(rc)
 		for {
 			record, err := reader.Read()
 			if err == io.EOF {
-				games = append(games, game)
+				if game.GameID != "" {
+					games = append(games, game)
+				}
 				break
 			}
+			if err != nil && !isRecordError(err) {
+				rc.Close()
+				return games, err
+			}
 			if err != nil {
-				log.Fatal(err)
+				errs = append(errs, newParseError(f.Name, reader.Line(), "", record, -1, err.Error()))
+				continue
 			}
 
 			if len(record) > 0 {
+				// the stat, line and event records of box score files
+				// are read by ReadBoxScores
+				if IsBoxScoreFile(f.Name) && boxScoreRecords[record[0]] {
+					lastType = -1
+					continue
+				}
 				recordType, ok := ParseEventType(record[0])
 				if !ok {
-					log.Println("Missing EventType: ", record[0])
+					errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 0, "unknown record type"))
+					continue
+				}
+				if skip && recordType != models.GameID {
 					continue
 				}
+				continued := lastType == models.Comment
+				lastType = recordType
 				switch recordType {
 				case models.GameID:
+					if len(record) < 2 {
+						errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, -1, "too few fields"))
+						if game.GameID != "" {
+							games = append(games, game)
+						}
+						game, skip = models.Game{}, true
+						continue
+					}
+					skip = false
 					if game.GameID != "" && game.GameID != record[1] {
 						games = append(games, game)
 					}
 					game = models.NewGame(record[1])
+					played = false
+				case models.Play:
+					played = true
+				case models.Comment:
+					// comments after the first play belong to the plays
+					if played {
+						continue
+					}
+					game.Comments = appendComment(game.Comments, parseComment(record), continued)
 				case models.Info:
 					infoType, ok := ParseInfoType(record[1])
 					if !ok {
-						//log.Println("Missing InfoType: ", record[1])
 						continue
 					}
 					switch infoType {
 					case models.VisitingTeam:
 						t, err := models.GetTeam(sess, record[2], year)
 						if err != nil {
-							log.Println("Failed to Find team: ", record[2], year)
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, "team not found"))
 							continue
 						}
 						game.Visitor = t.ID
 					case models.HomeTeam:
 						t, err := models.GetTeam(sess, record[2], year)
 						if err != nil {
-							log.Println("Failed to Find team: ", record[2], year)
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, "team not found"))
 							continue
 						}
 						game.Home = t.ID
+					case models.Site:
+						if len(record) < 3 {
+							continue
+						}
+						game.Site = record[2]
+						err := game.SetPark(sess)
+						if err != nil {
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, err.Error()))
+						} else if game.Site != "" && !game.Park.Valid {
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, "park not found"))
+						}
 					case models.GameDate:
 						played, err := time.Parse("2006/01/02", record[2])
 						if err != nil {
-							log.Println("Failed to parse game date", err)
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, err.Error()))
+							continue
 						}
 						game.Played = played
+					default:
+						if len(record) < 3 {
+							continue
+						}
+						err := ParseGameInfo(&game.GameInfo, infoType, record[2])
+						if err != nil {
+							errs = append(errs, newParseError(f.Name, reader.Line(), record[0], record, 2, err.Error()))
+						}
 					}
 				}
 			}
 		}
 		rc.Close()
 	}
-	return games
+	return games, errs.err()
 }
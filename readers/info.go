@@ -0,0 +1,141 @@
+package readers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+var (
+	dayNightMap = map[string]models.DayNight{
+		"day":   models.Day,
+		"night": models.Night,
+	}
+	windDirectionMap = map[string]models.WindDirection{
+		"fromcf": models.WindFromCenter,
+		"fromlf": models.WindFromLeft,
+		"fromrf": models.WindFromRight,
+		"ltor":   models.WindLeftToRight,
+		"rtol":   models.WindRightToLeft,
+		"tocf":   models.WindToCenter,
+		"tolf":   models.WindToLeft,
+		"torf":   models.WindToRight,
+	}
+	fieldConditionMap = map[string]models.FieldCondition{
+		"dry":    models.FieldDry,
+		"damp":   models.FieldDamp,
+		"wet":    models.FieldWet,
+		"soaked": models.FieldSoaked,
+	}
+	precipitationMap = map[string]models.Precipitation{
+		"none":    models.PrecipNone,
+		"drizzle": models.PrecipDrizzle,
+		"showers": models.PrecipShowers,
+		"rain":    models.PrecipRain,
+		"snow":    models.PrecipSnow,
+	}
+	skyMap = map[string]models.SkyCondition{
+		"sunny":    models.SkySunny,
+		"cloudy":   models.SkyCloudy,
+		"overcast": models.SkyOvercast,
+		"night":    models.SkyNight,
+		"dome":     models.SkyDome,
+	}
+	scoringMethodMap = map[string]models.ScoringMethod{
+		"park":  models.ScoredPark,
+		"tv":    models.ScoredTV,
+		"radio": models.ScoredRadio,
+	}
+	pitchDetailMap = map[string]models.PitchDetail{
+		"pitches": models.PitchDetailPitches,
+		"count":   models.PitchDetailCount,
+		"none":    models.PitchDetailNone,
+	}
+)
+
+// ParseGameInfo sets the field of info that an info record of type
+// infoType describes. Values of unknown, or empty, leave the field at its
+// unknown value. The team and date records are handled by ReadGames and
+// are ignored here.
+func ParseGameInfo(info *models.GameInfo, infoType models.InfoType, val string) error {
+	if val == "unknown" || val == "" {
+		return nil
+	}
+	var err error
+	switch infoType {
+	case models.Site:
+		info.Site = val
+	case models.GameNumber:
+		info.GameNumber, err = strconv.Atoi(val)
+	case models.StartTime:
+		info.StartTime = val
+	case models.DayOrNight:
+		v, ok := dayNightMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.DayNight = v
+	case models.UsedDH:
+		info.UseDH, err = strconv.ParseBool(val)
+	case models.UmpireHome:
+		info.UmpireHome = val
+	case models.UmpireFirst:
+		info.UmpireFirst = val
+	case models.UmpireSecond:
+		info.UmpireSecond = val
+	case models.UmpireThird:
+		info.UmpireThird = val
+	case models.Attendance:
+		info.Attendance, err = strconv.Atoi(val)
+	case models.Temperature:
+		info.Temperature, err = strconv.Atoi(val)
+	case models.WindDir:
+		v, ok := windDirectionMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.WindDirection = v
+	case models.WindSpeed:
+		info.WindSpeed, err = strconv.Atoi(val)
+	case models.FieldCond:
+		v, ok := fieldConditionMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.FieldCondition = v
+	case models.Precip:
+		v, ok := precipitationMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.Precipitation = v
+	case models.Sky:
+		v, ok := skyMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.Sky = v
+	case models.TimeOfGame:
+		info.TimeOfGame, err = strconv.Atoi(val)
+	case models.WinningPitch:
+		info.WinningPitcher = val
+	case models.LosingPitch:
+		info.LosingPitcher = val
+	case models.SavePitch:
+		info.SavePitcher = val
+	case models.HowScored:
+		v, ok := scoringMethodMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.HowScored = v
+	case models.PitchesInfo:
+		v, ok := pitchDetailMap[val]
+		if !ok {
+			return fmt.Errorf("unknown value %q", val)
+		}
+		info.Pitches = v
+	}
+	return err
+}
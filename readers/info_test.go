@@ -0,0 +1,70 @@
+package readers_test
+
+import (
+	"testing"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+func TestParseGameInfo(t *testing.T) {
+	convey.Convey("Given info records...", t, func() {
+		records := [][]string{
+			{"site", "ANA01"},
+			{"number", "0"},
+			{"starttime", "7:07PM"},
+			{"daynight", "night"},
+			{"usedh", "true"},
+			{"umphome", "cuzzp901"},
+			{"ump1b", "wolfj901"},
+			{"ump2b", "hudsm901"},
+			{"ump3b", "ripps901"},
+			{"attendance", "44742"},
+			{"temp", "66"},
+			{"winddir", "tolf"},
+			{"windspeed", "7"},
+			{"fieldcond", "unknown"},
+			{"precip", "none"},
+			{"sky", "cloudy"},
+			{"timeofgame", "176"},
+			{"wp", "ohtas001"},
+			{"lp", "tomlj001"},
+			{"save", ""},
+			{"howscored", "park"},
+			{"pitches", "pitches"},
+		}
+		info := models.NewGameInfo()
+		for _, r := range records {
+			infoType, ok := readers.ParseInfoType(r[0])
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(readers.ParseGameInfo(&info, infoType, r[1]), convey.ShouldBeNil)
+		}
+		convey.So(info, convey.ShouldResemble, models.GameInfo{
+			Site:           "ANA01",
+			StartTime:      "7:07PM",
+			DayNight:       models.Night,
+			UseDH:          true,
+			UmpireHome:     "cuzzp901",
+			UmpireFirst:    "wolfj901",
+			UmpireSecond:   "hudsm901",
+			UmpireThird:    "ripps901",
+			Attendance:     44742,
+			Temperature:    66,
+			WindDirection:  models.WindToLeft,
+			WindSpeed:      7,
+			Precipitation:  models.PrecipNone,
+			Sky:            models.SkyCloudy,
+			TimeOfGame:     176,
+			WinningPitcher: "ohtas001",
+			LosingPitcher:  "tomlj001",
+			HowScored:      models.ScoredPark,
+			Pitches:        models.PitchDetailPitches,
+		})
+
+		convey.Convey("Bad values are errors...", func() {
+			convey.So(readers.ParseGameInfo(&info, models.Sky, "hazy"), convey.ShouldNotBeNil)
+			convey.So(readers.ParseGameInfo(&info, models.Attendance, "many"), convey.ShouldNotBeNil)
+		})
+	})
+}
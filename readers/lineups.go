@@ -0,0 +1,33 @@
+package readers
+
+import (
+	"strconv"
+
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// parseAppearance parses the side, batting order and position of a start or
+// sub record, start,<player>,"<name>",<side>,<order>,<position>. On failure it
+// returns the offending field and the reason.
+func parseAppearance(record []string) (models.Appearance, int, string) {
+	appearance := models.Appearance{}
+	if len(record) < 6 {
+		return appearance, -1, "too few fields"
+	}
+	side, ok := ParseTeamSide(record[3])
+	if !ok {
+		return appearance, 3, "unknown team side"
+	}
+	order, err := strconv.Atoi(record[4])
+	if err != nil || order < 0 || order > 9 {
+		return appearance, 4, "invalid batting order"
+	}
+	position, ok := positionMap[record[5]]
+	if !ok {
+		return appearance, 5, "invalid position"
+	}
+	appearance.Side = side
+	appearance.BattingOrder = order
+	appearance.Position = position
+	return appearance, 0, ""
+}
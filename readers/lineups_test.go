@@ -0,0 +1,82 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const lineupEvents = `id,ANA201804020
+start,kinsi001,"Ian Kinsler",1,1,4
+start,troum001,"Mike Trout",1,2,8
+start,ohtas001,"Shohei Ohtani",1,0,1
+start,pujoa001,"Albert Pujols",1,4,10
+play,1,0,naqut001,12,FSBT,K
+play,1,0,zimmb001,00,X,S3/G
+sub,cozaz001,"Zack Cozart",1,1,4
+sub,youne003,"Eric Young",1,2,12
+start,lindf001,"Francisco Lindor",2,1,6
+sub,zzzzz001,"Nobody Known",1,9,11
+`
+
+func TestReadLineups(t *testing.T) {
+	convey.Convey("Given start and sub records ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM games").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(1, "ANA201804020"))
+		for _, p := range []string{"kinsi001", "troum001", "ohtas001", "pujoa001", "naqut001", "zimmb001", "cozaz001", "youne003"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+		}
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		records, err := readers.ReadGameEventsFromFile(sess, "2018ANA.EVA", strings.NewReader(lineupEvents))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 2)
+		convey.So(pe[0].Field, convey.ShouldEqual, 3)
+		convey.So(pe[1].Line, convey.ShouldEqual, 11)
+		convey.So(pe[1].Reason, convey.ShouldEqual, "player not found")
+
+		convey.So(len(records.Events), convey.ShouldEqual, 8)
+		appearances := records.Appearances
+		convey.So(len(appearances), convey.ShouldEqual, 6)
+		expected := []struct {
+			order    int
+			position models.Position
+			entry    int
+		}{
+			{1, models.PositionSecondBase, 1},
+			{2, models.PositionCenterField, 2},
+			{0, models.PositionPitcher, 3},
+			{4, models.PositionDesignatedHitter, 4},
+			{1, models.PositionSecondBase, 7},
+			{2, models.PositionPinchRunner, 8},
+		}
+		for i, e := range expected {
+			convey.So(appearances[i].Side, convey.ShouldEqual, models.HomeSide)
+			convey.So(appearances[i].BattingOrder, convey.ShouldEqual, e.order)
+			convey.So(appearances[i].Position, convey.ShouldEqual, e.position)
+			convey.So(appearances[i].EntryEvent, convey.ShouldEqual, e.entry)
+			convey.So(records.Events[e.entry-1].Sequence, convey.ShouldEqual, e.entry)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package readers
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gocraft/dbr"
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// ParkFile is Retrosheet's list of ballparks, which is not part of the
+// yearly event archives.
+const ParkFile = "parkcode.txt"
+
+// ReadParks reads the parks file,
+// <park id>,<name>,<aka>,<city>,<state>,<start>,<end>,<league>,<notes>,
+// skipping its header. Records that fail to parse are skipped and returned
+// as ParseErrors.
+func ReadParks(r io.Reader) ([]models.Park, error) {
+	parks := []models.Park{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return parks, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(ParkFile, line, "park", record, -1, err.Error()))
+			continue
+		}
+		if len(record) > 0 && strings.ToUpper(record[0]) == "PARKID" {
+			continue
+		}
+		if len(record) < 9 {
+			errs = append(errs, newParseError(ParkFile, line, "park", record, -1, "too few fields"))
+			continue
+		}
+		p := models.Park{
+			ParkID: record[0],
+			Name:   record[1],
+			AKA:    record[2],
+			City:   record[3],
+			State:  record[4],
+			League: -1,
+			Notes:  record[8],
+		}
+		if record[7] != "" {
+			p.League = ParseLeague(record[7])
+			if p.League < 0 {
+				errs = append(errs, newParseError(ParkFile, line, "park", record, 7, "unknown league"))
+				continue
+			}
+		}
+		p.Start, err = parseParkDate(record[5])
+		if err != nil {
+			errs = append(errs, newParseError(ParkFile, line, "park", record, 5, err.Error()))
+			continue
+		}
+		p.End, err = parseParkDate(record[6])
+		if err != nil {
+			errs = append(errs, newParseError(ParkFile, line, "park", record, 6, err.Error()))
+			continue
+		}
+		parks = append(parks, p)
+	}
+	return parks, errs.err()
+}
+
+// parseParkDate parses a date of the parks file. An empty date, such as the
+// end of a park still in use, is not valid.
+func parseParkDate(val string) (dbr.NullTime, error) {
+	if val == "" {
+		return dbr.NullTime{}, nil
+	}
+	t, err := time.Parse("1/2/2006", val)
+	if err != nil {
+		return dbr.NullTime{}, err
+	}
+	return dbr.NullTime{Time: t, Valid: true}, nil
+}
@@ -0,0 +1,38 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const parkFile = `PARKID,NAME,AKA,CITY,STATE,START,END,LEAGUE,NOTES
+ANA01,Angel Stadium of Anaheim,Edison Field; Anaheim Stadium,Anaheim,CA,04/19/1966,,AL,
+BOS07,Fenway Park,,Boston,MA,04/20/1912,,AL,
+CHI08,Wrigley Field,Weeghman Park; Cubs Park,Chicago,IL,04/23/1914,,XX,
+LON01,London Stadium,,London,,06/29/2019,06/30/2019,,
+`
+
+func TestReadParks(t *testing.T) {
+	convey.Convey("Given a parks file ...", t, func() {
+		parks, err := readers.ReadParks(strings.NewReader(parkFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 1)
+		convey.So(pe[0].Line, convey.ShouldEqual, 4)
+		convey.So(pe[0].Field, convey.ShouldEqual, 7)
+
+		convey.So(len(parks), convey.ShouldEqual, 3)
+		convey.So(parks[0].ParkID, convey.ShouldEqual, "ANA01")
+		convey.So(parks[0].AKA, convey.ShouldEqual, "Edison Field; Anaheim Stadium")
+		convey.So(parks[0].League, convey.ShouldEqual, models.American)
+		convey.So(parks[0].Start.Time, convey.ShouldEqual, time.Date(1966, 4, 19, 0, 0, 0, 0, time.UTC))
+		convey.So(parks[0].End.Valid, convey.ShouldBeFalse)
+		convey.So(parks[2].League, convey.ShouldEqual, -1)
+		convey.So(parks[2].End.Valid, convey.ShouldBeTrue)
+	})
+}
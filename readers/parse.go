@@ -1,7 +1,7 @@
 package readers
 
 import (
-	"log"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -9,36 +9,54 @@ import (
 	"github.com/wazupwiddat/retrosheet/models"
 )
 
+// playPattern pairs a compiled play-code pattern with the basic play it
+// yields. Patterns are anchored at both ends and tried in slice order, so the
+// first full match wins.
+type playPattern struct {
+	pattern *regexp.Regexp
+	play    models.BasicPlay
+}
+
+// modifierPattern pairs a compiled modifier pattern with its modifier.
+type modifierPattern struct {
+	pattern  *regexp.Regexp
+	modifier models.PlayModifier
+}
+
 var (
-	eventPatternMap = map[string]models.BasicPlay{
-		`^[0-9]{1}`:                                models.FlyBallOut,
-		`^[0-9]{2,}(\((.)\))?`:                     models.GroundBallOut,
-		`^[0-9]+\((.)\)[0-9]{1}`:                   models.GroundedIntoDoublePlay,
-		`^[0-9]+\((.)\)[0-9]+\((.)\)`:              models.LinedIntoDoublePlay,
-		`^[0-9]+\((.)\)[0-9]+\((.)\)[0-9]+\((.)\)`: models.LinedIntoTriplePlay,
-		`^C`:           models.CatcherInterference,
-		`^S[0-9]`:      models.Single,
-		`^D[0-9]`:      models.Double,
-		`^T[0-9]`:      models.Triple,
-		`^HP`:          models.HitByPitch,
-		`^H[R]?[0-9]?`: models.HomeRun,
-		`^DGR?`:        models.GroundRuleDouble,
-		`^E([0-9])?`:   models.Error,
-		`^FC([0-9])?`:  models.FieldersChoice,
-		`^FLE([0-9])?`: models.ErrorOnFlyBall,
-		`^K([0-9]+)?(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?`: models.StrikeOut,
-		`^NP`: models.NoPlay,
-		`^W(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?`:  models.Walk,
-		`^IW(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?`: models.IntentionalWalk,
-		`^BK`: models.Balk,
-		`^CS[2-3H]?\(([0-9A-Z]+)\)?`: models.CaughtStealing,
-		`^DI`: models.DefensiveIndifference,
-		`^OA`: models.OtherAdvance,
-		`^PB`: models.PassedBall,
-		`^WP`: models.WildPitch,
-		`^PO[1-3]\([0-9A-Z]+\)`:        models.PickOff,
-		`^POCS[2-3H]?\(([0-9A-Z]+)\)?`: models.PickOffCaughtStealing,
-		`^(SB[2-3H][;]?)+`:             models.StolenBase,
+	// eventPatterns is ordered from the most to the least specific code:
+	// multi-out fielding plays before single fielder outs, and longer letter
+	// codes before the codes they start with (POCS before PO, HP before H,
+	// DGR/DI before D, WP before W, CS before C).
+	eventPatterns = []playPattern{
+		{regexp.MustCompile(`^[0-9]+\((.)\)[0-9]+\((.)\)[0-9]+\((.)\)$`), models.LinedIntoTriplePlay},
+		{regexp.MustCompile(`^[0-9]+\((.)\)[0-9]+\((.)\)$`), models.LinedIntoDoublePlay},
+		{regexp.MustCompile(`^[0-9]+\((.)\)[0-9]{1}$`), models.GroundedIntoDoublePlay},
+		{regexp.MustCompile(`^[0-9]{2,}(\((.)\))?$`), models.GroundBallOut},
+		{regexp.MustCompile(`^[0-9]{1}$`), models.FlyBallOut},
+		{regexp.MustCompile(`^POCS[2-3H]?\(([0-9A-Z/]+)\)?$`), models.PickOffCaughtStealing},
+		{regexp.MustCompile(`^PO[1-3]\([0-9A-Z/]+\)$`), models.PickOff},
+		{regexp.MustCompile(`^PB$`), models.PassedBall},
+		{regexp.MustCompile(`^CS[2-3H]?\(([0-9A-Z/]+)\)?$`), models.CaughtStealing},
+		{regexp.MustCompile(`^(SB[2-3H][;]?)+$`), models.StolenBase},
+		{regexp.MustCompile(`^HP$`), models.HitByPitch},
+		{regexp.MustCompile(`^H[R]?[0-9]?$`), models.HomeRun},
+		{regexp.MustCompile(`^DGR?$`), models.GroundRuleDouble},
+		{regexp.MustCompile(`^DI$`), models.DefensiveIndifference},
+		{regexp.MustCompile(`^S[0-9]$`), models.Single},
+		{regexp.MustCompile(`^D[0-9]$`), models.Double},
+		{regexp.MustCompile(`^T[0-9]$`), models.Triple},
+		{regexp.MustCompile(`^FLE([0-9])?$`), models.ErrorOnFlyBall},
+		{regexp.MustCompile(`^FC([0-9])?$`), models.FieldersChoice},
+		{regexp.MustCompile(`^E([0-9])?$`), models.Error},
+		{regexp.MustCompile(`^K([0-9]+)?(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?$`), models.StrikeOut},
+		{regexp.MustCompile(`^IW(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?$`), models.IntentionalWalk},
+		{regexp.MustCompile(`^WP$`), models.WildPitch},
+		{regexp.MustCompile(`^W(\+WP|\+PB)?(\+SB[0-9])?(\+CS[0-9])?(\+PO[0-9])?(\+E[0-9])?$`), models.Walk},
+		{regexp.MustCompile(`^NP$`), models.NoPlay},
+		{regexp.MustCompile(`^BK$`), models.Balk},
+		{regexp.MustCompile(`^OA$`), models.OtherAdvance},
+		{regexp.MustCompile(`^C$`), models.CatcherInterference},
 	}
 	parseEventTypeMap = map[string]models.EventType{
 		"id":      models.GameID,
@@ -52,83 +70,185 @@ var (
 		"badj":    models.BatterAdj,
 		"ladj":    models.LineupAdj,
 		"padj":    models.PitcherAdj,
+		"radj":    models.RunnerAdj,
+		"presadj": models.PitcherResponsibilityAdj,
 	}
 	parseInfoTypeMap = map[string]models.InfoType{
-		"visteam":  models.VisitingTeam,
-		"hometeam": models.HomeTeam,
-		"date":     models.GameDate,
+		"visteam":    models.VisitingTeam,
+		"hometeam":   models.HomeTeam,
+		"date":       models.GameDate,
+		"site":       models.Site,
+		"number":     models.GameNumber,
+		"starttime":  models.StartTime,
+		"daynight":   models.DayOrNight,
+		"usedh":      models.UsedDH,
+		"umphome":    models.UmpireHome,
+		"ump1b":      models.UmpireFirst,
+		"ump2b":      models.UmpireSecond,
+		"ump3b":      models.UmpireThird,
+		"attendance": models.Attendance,
+		"temp":       models.Temperature,
+		"winddir":    models.WindDir,
+		"windspeed":  models.WindSpeed,
+		"fieldcond":  models.FieldCond,
+		"precip":     models.Precip,
+		"sky":        models.Sky,
+		"timeofgame": models.TimeOfGame,
+		"wp":         models.WinningPitch,
+		"lp":         models.LosingPitch,
+		"save":       models.SavePitch,
+		"howscored":  models.HowScored,
+		"pitches":    models.PitchesInfo,
+	}
+	leagueMap = map[string]models.League{
+		"A":   models.American,
+		"AL":  models.American,
+		"N":   models.National,
+		"NL":  models.National,
+		"F":   models.Federal,
+		"FL":  models.Federal,
+		"AA":  models.AmericanAssociation,
+		"UA":  models.UnionAssociation,
+		"PL":  models.Players,
+		"NNL": models.NegroNational,
+		"ECL": models.EasternColored,
+		"ANL": models.AmericanNegro,
+		"EWL": models.EastWest,
+		"NSL": models.NegroSouthern,
+		"NN2": models.NegroNationalII,
+		"NAL": models.NegroAmerican,
+		"NA":  models.NationalAssociation,
+	}
+	baseMap = map[string]int{
+		"B": 0,
+		"1": 1,
+		"2": 2,
+		"3": 3,
+		"H": 4,
+	}
+	pitchTypeMap = map[rune]models.PitchType{
+		'B': models.PitchBall,
+		'C': models.PitchCalledStrike,
+		'S': models.PitchSwingingStrike,
+		'F': models.PitchFoul,
+		'T': models.PitchFoulTip,
+		'L': models.PitchFoulBunt,
+		'O': models.PitchFoulTipBunt,
+		'M': models.PitchMissedBunt,
+		'X': models.PitchInPlay,
+		'Y': models.PitchInPlayOnPitchout,
+		'H': models.PitchHitBatter,
+		'I': models.PitchIntentionalBall,
+		'P': models.PitchPitchout,
+		'Q': models.PitchSwingingOnPitchout,
+		'R': models.PitchFoulOnPitchout,
+		'K': models.PitchStrike,
+		'A': models.PitchAutomaticStrike,
+		'V': models.PitchAutomaticBall,
+		'N': models.PitchNoPitch,
+		'U': models.PitchUnknown,
+		'1': models.PitchPickoffFirst,
+		'2': models.PitchPickoffSecond,
+		'3': models.PitchPickoffThird,
 	}
 	parseInningHalfMap = map[string]models.InningHalf{
 		"0": models.TopHalf,
 		"1": models.BottomHalf,
 	}
+	parseTeamSideMap = map[string]models.TeamSide{
+		"0": models.VisitingSide,
+		"1": models.HomeSide,
+	}
+	hitDirectionMap = map[string]models.HitDirection{
+		"L": models.DirectionLeft,
+		"M": models.DirectionMiddle,
+		"R": models.DirectionRight,
+	}
+	hitDepthMap = map[string]models.HitDepth{
+		"S":  models.DepthShallow,
+		"D":  models.DepthDeep,
+		"XD": models.DepthExtraDeep,
+	}
 	positionMap = map[string]models.Position{
-		"1": models.PositionPitcher,
-		"2": models.PositionCatcher,
-		"C": models.PositionCatcher,
-		"3": models.PositionFirstBase,
-		"4": models.PositionSecondBase,
-		"5": models.PositionThirdBase,
-		"6": models.PositionShortStop,
-		"7": models.PositionLeftField,
-		"8": models.PositionCenterField,
-		"9": models.PositionRightField,
-	}
-	playModifierMap = map[string]models.PlayModifier{
-		"^AP$": models.ModifierAppealPlay,
-		"^BP([0-9]|$)([0-9A-Z]+)?": models.ModifierPopupBunt,
-		"^BG([0-9]|$)([0-9A-Z]+)?": models.ModifierGroundBallBunt,
-		"^BGDP$":                   models.ModifierGroundBallDoublePlayBunt,
-		"^BINT":                    models.ModifierBatterInterference,
-		"^BL([0-9]|$)([0-9A-Z]+)?": models.ModifierLinedDriveBunt,
-		"^BOOT$":                   models.ModifierBattingOutOfTurn,
-		"^BPDP$":                   models.ModifierPopupDoublePlayBunt,
-		"^BR$":                     models.ModifierRunnerHitByBattedBall,
-		"^C$":                      models.ModifierCalledThirdStrike,
-		"^COUB$":                   models.ModifierCourtesyBatter,
-		"^COUF$":                   models.ModifierCourtesyFielder,
-		"^COUR$":                   models.ModifierCourtesyRunner,
-		"^DP$":                     models.ModifierUnspecifiedDoublePlay,
-		"^F([0-9]|$)([0-9A-Z]+)?": models.ModifierFlyBall,
-		"^E([0-9])?":              models.ModifierErrorOn,
-		"^FDP$":                   models.ModifierFlyBallDoublePlay,
-		"^FINT$":                  models.ModifierFanInterference,
-		"^FL$":                    models.ModifierFoulBall,
-		"^FO$":                    models.ModifierForceOut,
-		"^G([0-9]|$)([0-9A-Z]+)?": models.ModifierGroundBall,
-		"^GDP$":                   models.ModifierGroundBallDoublePlay,
-		"^GTP$":                   models.ModifierGroundBallTriplePlay,
-		"^IF$":                    models.ModifierInfieldFlyRule,
-		"^INT$":                   models.ModifierInterference,
-		"^IPHR$":                  models.ModifierInsideTheParkHomeRun,
-		"^L([0-9]|$)([0-9A-Z]+)?": models.ModifierLinedDrive,
-		"^LDP$":                   models.ModifierLinedIntoDoublePlay,
-		"LTP$":                    models.ModifierLinedIntoTriplePlay,
-		"^MREV$":                  models.ModifierManagerChallenge,
-		"^NDP$":                   models.ModifierNoDoublePlay,
-		"^OBS$":                   models.ModifierObstruction,
-		"^P([0-9]|$)([0-9A-Z]+)?": models.ModifierPopup,
-		"^PASS$":                  models.ModifierPassedRunner,
-		"^R([0-9])?":              models.ModifierRelayThrow,
-		"^SF$":                    models.ModifierSacrificeFly,
-		"^SH$":                    models.ModifierSacrificeBunt,
-		"^TH([0-9])?":             models.ModifierThrowing,
-		"^TP$":                    models.ModifierUnspecifiedTriplePlay,
-		"^UINT$":                  models.ModifierUmpireInterference,
-		"^UREV$":                  models.ModifierUmpireReviewCallOnField,
-	}
-	runnerAdvanceMap = map[string]models.RunnerAdvance{
-		"^B-1[#]?": {StartBase: 0, FinishBase: 1},
-		"^B-2[#]?": {StartBase: 0, FinishBase: 2},
-		"^B-3[#]?": {StartBase: 0, FinishBase: 3},
-		"^B-H[#]?": {StartBase: 0, FinishBase: 4},
-		"^1-2[#]?": {StartBase: 1, FinishBase: 2},
-		"^1-3[#]?": {StartBase: 1, FinishBase: 3},
-		"^1-H[#]?": {StartBase: 1, FinishBase: 4},
-		"^2-3[#]?": {StartBase: 2, FinishBase: 3},
-		"^2-H[#]?": {StartBase: 2, FinishBase: 4},
-		"^3-H[#]?": {StartBase: 3, FinishBase: 4},
+		"1":  models.PositionPitcher,
+		"2":  models.PositionCatcher,
+		"C":  models.PositionCatcher,
+		"3":  models.PositionFirstBase,
+		"4":  models.PositionSecondBase,
+		"5":  models.PositionThirdBase,
+		"6":  models.PositionShortStop,
+		"7":  models.PositionLeftField,
+		"8":  models.PositionCenterField,
+		"9":  models.PositionRightField,
+		"10": models.PositionDesignatedHitter,
+		"11": models.PositionPinchHitter,
+		"12": models.PositionPinchRunner,
+	}
+	// playModifierPatterns lists the compound codes (BGDP, GDP, LDP, ...)
+	// ahead of the single letter codes that share their first letter.
+	playModifierPatterns = []modifierPattern{
+		{regexp.MustCompile(`^AP$`), models.ModifierAppealPlay},
+		{regexp.MustCompile(`^BGDP$`), models.ModifierGroundBallDoublePlayBunt},
+		{regexp.MustCompile(`^BPDP$`), models.ModifierPopupDoublePlayBunt},
+		{regexp.MustCompile(`^BINT$`), models.ModifierBatterInterference},
+		{regexp.MustCompile(`^BOOT$`), models.ModifierBattingOutOfTurn},
+		{regexp.MustCompile(`^BR$`), models.ModifierRunnerHitByBattedBall},
+		{regexp.MustCompile(`^BG([0-9]|$)([0-9A-Z]+)?$`), models.ModifierGroundBallBunt},
+		{regexp.MustCompile(`^BL([0-9]|$)([0-9A-Z]+)?$`), models.ModifierLinedDriveBunt},
+		{regexp.MustCompile(`^BP([0-9]|$)([0-9A-Z]+)?$`), models.ModifierPopupBunt},
+		{regexp.MustCompile(`^COUB$`), models.ModifierCourtesyBatter},
+		{regexp.MustCompile(`^COUF$`), models.ModifierCourtesyFielder},
+		{regexp.MustCompile(`^COUR$`), models.ModifierCourtesyRunner},
+		{regexp.MustCompile(`^C$`), models.ModifierCalledThirdStrike},
+		{regexp.MustCompile(`^DP$`), models.ModifierUnspecifiedDoublePlay},
+		{regexp.MustCompile(`^E([0-9])?$`), models.ModifierErrorOn},
+		{regexp.MustCompile(`^FDP$`), models.ModifierFlyBallDoublePlay},
+		{regexp.MustCompile(`^FINT$`), models.ModifierFanInterference},
+		{regexp.MustCompile(`^FL$`), models.ModifierFoulBall},
+		{regexp.MustCompile(`^FO$`), models.ModifierForceOut},
+		{regexp.MustCompile(`^F([0-9]|$)([0-9A-Z]+)?$`), models.ModifierFlyBall},
+		{regexp.MustCompile(`^GDP$`), models.ModifierGroundBallDoublePlay},
+		{regexp.MustCompile(`^GTP$`), models.ModifierGroundBallTriplePlay},
+		{regexp.MustCompile(`^G([0-9]|$)([0-9A-Z]+)?$`), models.ModifierGroundBall},
+		{regexp.MustCompile(`^IF$`), models.ModifierInfieldFlyRule},
+		{regexp.MustCompile(`^INT$`), models.ModifierInterference},
+		{regexp.MustCompile(`^IPHR$`), models.ModifierInsideTheParkHomeRun},
+		{regexp.MustCompile(`^LDP$`), models.ModifierLinedIntoDoublePlay},
+		{regexp.MustCompile(`^LTP$`), models.ModifierLinedIntoTriplePlay},
+		{regexp.MustCompile(`^L([0-9]|$)([0-9A-Z]+)?$`), models.ModifierLinedDrive},
+		{regexp.MustCompile(`^MREV$`), models.ModifierManagerChallenge},
+		{regexp.MustCompile(`^NDP$`), models.ModifierNoDoublePlay},
+		{regexp.MustCompile(`^OBS$`), models.ModifierObstruction},
+		{regexp.MustCompile(`^PASS$`), models.ModifierPassedRunner},
+		{regexp.MustCompile(`^P([0-9]|$)([0-9A-Z]+)?$`), models.ModifierPopup},
+		{regexp.MustCompile(`^R([0-9])?$`), models.ModifierRelayThrow},
+		{regexp.MustCompile(`^SF$`), models.ModifierSacrificeFly},
+		{regexp.MustCompile(`^SH$`), models.ModifierSacrificeBunt},
+		{regexp.MustCompile(`^TH([0-9])?$`), models.ModifierThrowing},
+		{regexp.MustCompile(`^TP$`), models.ModifierUnspecifiedTriplePlay},
+		{regexp.MustCompile(`^UINT$`), models.ModifierUmpireInterference},
+		{regexp.MustCompile(`^UREV$`), models.ModifierUmpireReviewCallOnField},
+		{regexp.MustCompile(`^[0-9]+([LMR])?(S|D|XD)?(F)?$`), models.ModifierHitLocation},
+	}
+	// hitLocationModifiers are the batted ball modifiers whose trailing
+	// digits are a hit location. The digits of the others, as in TH2, E5 or
+	// R4, name a fielder or a base.
+	hitLocationModifiers = map[models.PlayModifier]bool{
+		models.ModifierGroundBall:     true,
+		models.ModifierLinedDrive:     true,
+		models.ModifierFlyBall:        true,
+		models.ModifierPopup:          true,
+		models.ModifierGroundBallBunt: true,
+		models.ModifierPopupBunt:      true,
+		models.ModifierLinedDriveBunt: true,
+		models.ModifierHitLocation:    true,
 	}
+	nonDigitRegexp     = regexp.MustCompile(`[^0-9]+`)
+	digitsRegexp       = regexp.MustCompile(`[0-9]+`)
+	forcedRunnerRegexp = regexp.MustCompile(`(\([0-9]+\))|(\(B)\)`)
+	hitLocationRegexp  = regexp.MustCompile(`([0-9]+)([LMR])?(S|D|XD)?(F)?$`)
+	fieldingRegexp     = regexp.MustCompile(`^[0-9E]+$`)
+	errorOnRegexp      = regexp.MustCompile(`^E([0-9])$`)
 )
 
 func ParseEventType(val string) (models.EventType, bool) {
@@ -147,14 +267,15 @@ func ParseInfoType(val string) (models.InfoType, bool) {
 	return i, ok
 }
 
+// ParseLeague parses the league code of a team file, either the single
+// letter used for the major leagues or the abbreviation Retrosheet uses for
+// the other leagues, e.g. AA or NNL. Unknown codes return -1.
 func ParseLeague(val string) models.League {
-	if val == "A" || val == "a" {
-		return models.American
-	}
-	if val == "N" || val == "n" {
-		return models.National
+	l, ok := leagueMap[strings.ToUpper(val)]
+	if !ok {
+		return -1
 	}
-	return -1
+	return l
 }
 
 func ParseHanded(val string) models.Handed {
@@ -170,17 +291,15 @@ func ParseHanded(val string) models.Handed {
 	return -1
 }
 
-func ParseYear(val string) int {
-	reg, err := regexp.Compile("[^0-9]+")
-	if err != nil {
-		log.Fatal(err)
-	}
-	processedString := reg.ReplaceAllString(val, "")
+// ParseYear returns the year embedded in a Retrosheet file name such as
+// 2018ANA.EVA or TEAM2018.
+func ParseYear(val string) (int, error) {
+	processedString := nonDigitRegexp.ReplaceAllString(val, "")
 	i, err := strconv.Atoi(processedString)
 	if err != nil {
-		log.Fatal(err)
+		return 0, fmt.Errorf("no year in file name %q", val)
 	}
-	return i
+	return i, nil
 }
 
 func ParseInning(val string) int {
@@ -191,6 +310,23 @@ func ParseInning(val string) int {
 	return i
 }
 
+// ParseBallsStrikes parses a two digit count such as 12 into balls and
+// strikes. Either digit is models.UnknownCount when it is not a number, as
+// with the ?? used in older seasons.
+func ParseBallsStrikes(val string) (int, int) {
+	if len(val) != 2 {
+		return models.UnknownCount, models.UnknownCount
+	}
+	return parseCountDigit(val[0]), parseCountDigit(val[1])
+}
+
+func parseCountDigit(c byte) int {
+	if c < '0' || c > '9' {
+		return models.UnknownCount
+	}
+	return int(c - '0')
+}
+
 func ParseInningHalf(val string) (models.InningHalf, bool) {
 	i, ok := parseInningHalfMap[val]
 	if !ok {
@@ -200,14 +336,49 @@ func ParseInningHalf(val string) (models.InningHalf, bool) {
 
 }
 
-func ParseBasicPlay(val string) models.BasicPlay {
-	for regx, result := range eventPatternMap {
-		reg, err := regexp.Compile(regx)
-		if err != nil {
-			log.Fatal(err)
+func ParseTeamSide(val string) (models.TeamSide, bool) {
+	s, ok := parseTeamSideMap[val]
+	if !ok {
+		return -1, ok
+	}
+	return s, ok
+}
+
+// ParsePitches parses a pitch sequence such as FSBT or 11C>X. The >, *, + and
+// . prefixes are folded into the pitch that follows them; unknown characters
+// are skipped.
+func ParsePitches(val string) []models.Pitch {
+	pitches := []models.Pitch{}
+	next := models.Pitch{}
+	for _, c := range val {
+		switch c {
+		case '>':
+			next.RunnerGoing = true
+		case '*':
+			next.Blocked = true
+		case '+':
+			next.CatcherPickoff = true
+		case '.':
+			next.NonPitchAction = true
+		default:
+			pt, ok := pitchTypeMap[c]
+			if !ok {
+				continue
+			}
+			next.Type = pt
+			pitches = append(pitches, next)
+			next = models.Pitch{}
 		}
-		if v := reg.FindString(val); v == val {
-			return result
+	}
+	return pitches
+}
+
+// ParseBasicPlay returns the first play in eventPatterns whose pattern
+// matches the whole of val, or NoPlay when none does.
+func ParseBasicPlay(val string) models.BasicPlay {
+	for _, p := range eventPatterns {
+		if p.pattern.MatchString(val) {
+			return p.play
 		}
 	}
 
@@ -218,14 +389,13 @@ func parseFieldersInPlay(val string, play models.BasicPlay) []models.Position {
 	var fieldersStr string
 	switch play {
 	case models.LinedIntoTriplePlay, models.LinedIntoDoublePlay, models.GroundedIntoDoublePlay:
-		fieldersStr = removeStringRegex(`(\([0-9]+\))|(\(B)\)`, val)
+		fieldersStr = forcedRunnerRegexp.ReplaceAllString(val, "")
 	case models.CaughtStealing:
-		fieldersStr = matchStringRegex(`(\([0-9]+\))`, val)
-		fieldersStr = matchStringRegex(`[0-9]+`, val)
+		fieldersStr = digitsRegexp.FindString(val)
 	case models.HomeRun:
 		// do nothing here
 	default:
-		fieldersStr = matchStringRegex(`[0-9]+`, val)
+		fieldersStr = digitsRegexp.FindString(val)
 	}
 
 	fielders := []models.Position{}
@@ -236,16 +406,10 @@ func parseFieldersInPlay(val string, play models.BasicPlay) []models.Position {
 	return fielders
 }
 
-func parseExtraEvents(val string, play models.BasicPlay) []models.BasicPlay {
+func parseExtraEvents(events []EventNode) []models.BasicPlay {
 	extras := []models.BasicPlay{}
-	switch play {
-	case models.Walk, models.IntentionalWalk, models.StrikeOut:
-		mods := strings.Split(val, "+")
-		if len(mods) > 1 {
-			for _, mod := range mods[1:] {
-				extras = append(extras, ParseBasicPlay(mod))
-			}
-		}
+	for _, ev := range events {
+		extras = append(extras, ParseBasicPlay(ev.Code))
 	}
 	return extras
 }
@@ -253,17 +417,13 @@ func parseExtraEvents(val string, play models.BasicPlay) []models.BasicPlay {
 func parsePlayMod(vals []string, play models.BasicPlay) []models.Modifier {
 	mods := []models.Modifier{}
 	for _, val := range vals {
-		for regx, result := range playModifierMap {
-			reg, err := regexp.Compile(regx)
-			if err != nil {
-				log.Fatal(err)
-			}
-			if v := reg.FindString(val); v == val {
+		for _, p := range playModifierPatterns {
+			if p.pattern.MatchString(val) {
 				mod := models.Modifier{
-					PlayModifier: result,
+					PlayModifier: p.modifier,
 				}
 
-				mod.Location = parseModifierHitLocation(val, result)
+				mod.Location = parseModifierHitLocation(val, p.modifier)
 				mods = append(mods, mod)
 				break
 			}
@@ -273,92 +433,204 @@ func parsePlayMod(vals []string, play models.BasicPlay) []models.Modifier {
 	return mods
 }
 
+// parseModifierHitLocation decodes the hit location that follows a batted
+// ball modifier code, e.g. the 78XD in F78XD, into its zone, direction, depth
+// and foul flag. Other modifiers have no location.
 func parseModifierHitLocation(val string, mod models.PlayModifier) models.HitLocation {
-	loc := matchStringRegex(`[0-9](.+)?`, val)
-	return models.HitLocation(loc)
+	loc := models.HitLocation{}
+	if !hitLocationModifiers[mod] {
+		return loc
+	}
+	m := hitLocationRegexp.FindStringSubmatch(val)
+	if m == nil {
+		return loc
+	}
+	for _, f := range m[1] {
+		loc.Zone = append(loc.Zone, positionMap[string(f)])
+	}
+	loc.Direction = hitDirectionMap[m[2]]
+	loc.Depth = hitDepthMap[m[3]]
+	loc.Foul = m[4] == "F"
+	return loc
 }
 
-func ParseRunnerAdvances(vals []string) []models.RunnerAdvance {
+// parseEventRunners returns the runner movements made by a single event.
+// In a fielding play such as 64(1)3, (1) is the runner from first forced at
+// second; runners caught off base on a line drive or fly ball are out at the
+// base they started from. Stolen bases, caught stealing and pickoffs move
+// the runner named by the base in the event, and an error in the fielding
+// of a caught stealing or pickoff, as in CS2(2E4), leaves the runner safe.
+func parseEventRunners(ev EventNode, play models.BasicPlay) []models.RunnerAdvance {
 	runners := []models.RunnerAdvance{}
-	for _, r := range vals {
-		just := removeStringRegex(`(\(.+\))`, r)
-		for regx, result := range runnerAdvanceMap {
-			reg, err := regexp.Compile(regx)
-			if err != nil {
-				log.Fatal(err)
+	switch play {
+	case models.FlyBallOut, models.GroundBallOut, models.GroundedIntoDoublePlay,
+		models.LinedIntoDoublePlay, models.LinedIntoTriplePlay:
+		for _, g := range ev.Groups {
+			start, ok := baseMap[g]
+			if !ok || start == 0 {
+				continue
 			}
-			if v := reg.FindString(just); v == just {
-				runners = append(runners, result)
-				break
+			finish := start + 1
+			if play == models.FlyBallOut || play == models.LinedIntoDoublePlay || play == models.LinedIntoTriplePlay {
+				finish = start
 			}
+			runners = append(runners, models.RunnerAdvance{StartBase: start, FinishBase: finish, Out: true})
+		}
+	case models.StolenBase, models.CaughtStealing, models.PickOff, models.PickOffCaughtStealing:
+		code := strings.SplitN(ev.Code, "(", 2)[0]
+		base, ok := baseMap[code[len(code)-1:]]
+		if !ok || base == 0 {
+			return runners
 		}
-		if len(runners) == 0 {
-			// log.Println("Unknown runner advance: ", r)
+		ra := models.RunnerAdvance{StartBase: base - 1, FinishBase: base}
+		if play == models.PickOff {
+			ra.StartBase = base
 		}
+		if play == models.StolenBase {
+			return append(runners, ra)
+		}
+		ra.Out = true
+		for _, g := range ev.Groups {
+			parseRunnerDetail(&ra, g)
+		}
+		if len(ra.Errors) > 0 {
+			ra.Out = false
+			ra.ErrorNegatedOut = true
+		}
+		runners = append(runners, ra)
+	}
+	return runners
+}
 
+// ParseRunnerAdvances parses the ; separated runner advances that follow the
+// . in a play. Advances that do not match the Retrosheet format are dropped.
+func ParseRunnerAdvances(vals []string) []models.RunnerAdvance {
+	runners := []models.RunnerAdvance{}
+	for _, r := range vals {
+		ra, ok := parseRunnerAdvance(r)
+		if !ok {
+			continue
+		}
+		runners = append(runners, ra)
 	}
 	return runners
 }
 
-func ParseEventDetail(val string) (models.EventDetail, bool) {
-	// <basicplay>/<basicplaymodifier>.<runners>
+func parseRunnerAdvance(val string) (models.RunnerAdvance, bool) {
+	p, err := newPlayParser(val)
+	if err != nil {
+		return models.RunnerAdvance{}, false
+	}
+	node, err := p.parseAdvance()
+	if err != nil || p.peek().kind != tokenEOF {
+		return models.RunnerAdvance{}, false
+	}
+	return runnerAdvanceFromNode(node), true
+}
+
+func runnerAdvanceFromNode(node AdvanceNode) models.RunnerAdvance {
+	ra := models.RunnerAdvance{
+		StartBase:  baseMap[node.Start],
+		FinishBase: baseMap[node.Finish],
+		Out:        node.Out,
+	}
+	for _, detail := range node.Details {
+		parseRunnerDetail(&ra, detail)
+	}
+	if ra.Out && len(ra.Errors) > 0 {
+		ra.Out = false
+		ra.ErrorNegatedOut = true
+	}
+	return ra
+}
+
+// parseRunnerDetail applies a single parenthesized runner detail, either a
+// run attribution such as UR or a fielding sequence like 52, E8/TH or 6E5.
+func parseRunnerDetail(ra *models.RunnerAdvance, val string) {
+	switch val {
+	case "UR":
+		ra.Unearned = true
+		return
+	case "TUR":
+		ra.TeamUnearned = true
+		return
+	case "NR", "NORBI":
+		ra.NoRBI = true
+		return
+	case "RBI":
+		ra.RBI = true
+		return
+	}
+
+	fielding := strings.Split(val, "/")[0]
+	if !fieldingRegexp.MatchString(fielding) {
+		return
+	}
+	isError := false
+	for _, f := range fielding {
+		if f == 'E' {
+			isError = true
+			continue
+		}
+		pos := models.Position(f - '0')
+		if isError {
+			ra.Errors = append(ra.Errors, pos)
+			isError = false
+		}
+		ra.Fielders = append(ra.Fielders, pos)
+	}
+}
 
+// ParseEventDetail parses the play field of a play record,
+// <basicplay>[+<extraplay>]/<modifier>.<runners>, into an EventDetail. A
+// malformed play returns a *PlaySyntaxError.
+func ParseEventDetail(val string) (models.EventDetail, error) {
 	eventDetail := models.EventDetail{}
 
-	// <basicplay>/<basicplaymodifier>
-	basicPlay, modifiers, ok := splitPlayWithModifier(val)
+	node, err := ParsePlay(val)
+	if err != nil {
+		return eventDetail, err
+	}
+
+	basicPlay := node.Primary[0].Code
 	eventDetail.Play = ParseBasicPlay(basicPlay)
 	eventDetail.Fielders = parseFieldersInPlay(basicPlay, eventDetail.Play)
 
 	// events on walks and strikeouts
-	eventDetail.ExtraPlays = parseExtraEvents(basicPlay, eventDetail.Play)
+	eventDetail.ExtraPlays = parseExtraEvents(node.Secondary)
 
+	modifiers := []string{}
+	for _, m := range node.Modifiers {
+		modifiers = append(modifiers, m.Code)
+	}
 	eventDetail.Modifiers = parsePlayMod(modifiers, eventDetail.Play)
 
-	// multiple runners are separated by ;
-	// runners advance safely are indicated with a - (2-3)
-	// runners thrown out are indicated with a X (2X3)
-
-	runners, ok := splitRunners(val)
-	if !ok {
-		log.Println("Unknown runner scenario: ", basicPlay)
-		return eventDetail, ok
+	advances := []models.RunnerAdvance{}
+	explicit := map[int]bool{}
+	for _, a := range node.Advances {
+		ra := runnerAdvanceFromNode(a)
+		explicit[ra.StartBase] = true
+		advances = append(advances, ra)
 	}
-
-	eventDetail.RunnerAdv = ParseRunnerAdvances(runners)
-
-	return eventDetail, ok
-}
-
-func splitPlayWithModifier(val string) (string, []string, bool) {
-	playWithModifier := strings.Split(val, ".")
-	if len(playWithModifier) > 0 {
-		s := strings.Split(playWithModifier[0], "/")
-		return s[0], s[1:], true
+	eventDetail.RunnerAdv = []models.RunnerAdvance{}
+	events := append([]EventNode{}, node.Primary...)
+	events = append(events, node.Secondary...)
+	for i, ev := range events {
+		play := eventDetail.Play
+		if i >= len(node.Primary) {
+			play = eventDetail.ExtraPlays[i-len(node.Primary)]
+		} else if i > 0 {
+			play = ParseBasicPlay(ev.Code)
+		}
+		for _, ra := range parseEventRunners(ev, play) {
+			if !explicit[ra.StartBase] {
+				eventDetail.RunnerAdv = append(eventDetail.RunnerAdv, ra)
+			}
+		}
 	}
-	return "NO PLAY", []string{}, false
-}
+	eventDetail.RunnerAdv = append(eventDetail.RunnerAdv, advances...)
 
-func splitRunners(val string) ([]string, bool) {
-	runners := strings.Split(val, ".")
-	if len(runners) > 1 {
-		s := strings.Split(runners[1], ";")
-		return s[0:], true
-	}
-	return []string{}, true
-}
+	eventDetail.Credits = parseCredits(node, eventDetail)
 
-func removeStringRegex(regex string, val string) string {
-	reg, err := regexp.Compile(regex)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return reg.ReplaceAllString(val, "")
-}
-func matchStringRegex(regex string, val string) string {
-	reg, err := regexp.Compile(regex)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return reg.FindString(val)
+	return eventDetail, nil
 }
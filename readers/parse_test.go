@@ -17,7 +17,16 @@ func TestParseRunnerAdvances(t *testing.T) {
 		}{
 			{
 				[]string{"BXH(E8/TH)(UR)"},
-				[]models.RunnerAdvance{},
+				[]models.RunnerAdvance{
+					{
+						StartBase:       0,
+						FinishBase:      4,
+						Fielders:        []models.Position{models.PositionCenterField},
+						Errors:          []models.Position{models.PositionCenterField},
+						ErrorNegatedOut: true,
+						Unearned:        true,
+					},
+				},
 			},
 			{
 				[]string{"B-H(E8/TH)(UR)"},
@@ -25,9 +34,61 @@ func TestParseRunnerAdvances(t *testing.T) {
 					{
 						StartBase:  0,
 						FinishBase: 4,
+						Fielders:   []models.Position{models.PositionCenterField},
+						Errors:     []models.Position{models.PositionCenterField},
+						Unearned:   true,
+					},
+				},
+			},
+			{
+				[]string{"2XH(52)", "1X3(6E5)"},
+				[]models.RunnerAdvance{
+					{
+						StartBase:  2,
+						FinishBase: 4,
+						Out:        true,
+						Fielders: []models.Position{
+							models.PositionThirdBase,
+							models.PositionCatcher,
+						},
+					},
+					{
+						StartBase:  1,
+						FinishBase: 3,
+						Fielders: []models.Position{
+							models.PositionShortStop,
+							models.PositionThirdBase,
+						},
+						Errors:          []models.Position{models.PositionThirdBase},
+						ErrorNegatedOut: true,
+					},
+				},
+			},
+			{
+				[]string{"3-H(NR)(TUR)", "2-H(RBI)", "1-3(NORBI)"},
+				[]models.RunnerAdvance{
+					{
+						StartBase:    3,
+						FinishBase:   4,
+						NoRBI:        true,
+						TeamUnearned: true,
+					},
+					{
+						StartBase:  2,
+						FinishBase: 4,
+						RBI:        true,
+					},
+					{
+						StartBase:  1,
+						FinishBase: 3,
+						NoRBI:      true,
 					},
 				},
 			},
+			{
+				[]string{"B-4", "Z-H"},
+				[]models.RunnerAdvance{},
+			},
 			{
 				[]string{"1-2#"},
 				[]models.RunnerAdvance{
@@ -197,6 +258,38 @@ func TestPlayMatching(t *testing.T) {
 				"8",
 				models.FlyBallOut,
 			},
+			{
+				"54(B)",
+				models.GroundBallOut,
+			},
+			{
+				"WP",
+				models.WildPitch,
+			},
+			{
+				"PB",
+				models.PassedBall,
+			},
+			{
+				"DI",
+				models.DefensiveIndifference,
+			},
+			{
+				"DG",
+				models.GroundRuleDouble,
+			},
+			{
+				"OA",
+				models.OtherAdvance,
+			},
+			{
+				"BK",
+				models.Balk,
+			},
+			{
+				"",
+				models.NoPlay,
+			},
 		}
 		for _, test := range tests {
 			convey.Convey("Parse "+test.t+"...", func() {
@@ -207,6 +300,211 @@ func TestPlayMatching(t *testing.T) {
 	})
 }
 
+func TestParsePitches(t *testing.T) {
+	convey.Convey("Given pitch sequences...", t, func() {
+		tests := []struct {
+			t string
+			v []models.Pitch
+		}{
+			{
+				"FSBT",
+				[]models.Pitch{
+					{Type: models.PitchFoul},
+					{Type: models.PitchSwingingStrike},
+					{Type: models.PitchBall},
+					{Type: models.PitchFoulTip},
+				},
+			},
+			{
+				"11C>X",
+				[]models.Pitch{
+					{Type: models.PitchPickoffFirst},
+					{Type: models.PitchPickoffFirst},
+					{Type: models.PitchCalledStrike},
+					{Type: models.PitchInPlay, RunnerGoing: true},
+				},
+			},
+			{
+				"CB*B+2.IP",
+				[]models.Pitch{
+					{Type: models.PitchCalledStrike},
+					{Type: models.PitchBall},
+					{Type: models.PitchBall, Blocked: true},
+					{Type: models.PitchPickoffSecond, CatcherPickoff: true},
+					{Type: models.PitchIntentionalBall, NonPitchAction: true},
+					{Type: models.PitchPitchout},
+				},
+			},
+			{
+				"",
+				[]models.Pitch{},
+			},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				p := readers.ParsePitches(test.t)
+				convey.So(p, convey.ShouldResemble, test.v)
+			})
+		}
+	})
+}
+
+func TestParseBallsStrikes(t *testing.T) {
+	convey.Convey("Given counts...", t, func() {
+		tests := []struct {
+			t       string
+			balls   int
+			strikes int
+		}{
+			{"12", 1, 2},
+			{"30", 3, 0},
+			{"00", 0, 0},
+			{"??", models.UnknownCount, models.UnknownCount},
+			{"", models.UnknownCount, models.UnknownCount},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				balls, strikes := readers.ParseBallsStrikes(test.t)
+				convey.So(balls, convey.ShouldEqual, test.balls)
+				convey.So(strikes, convey.ShouldEqual, test.strikes)
+			})
+		}
+	})
+}
+
+func TestParseLeague(t *testing.T) {
+	convey.Convey("Given league codes...", t, func() {
+		tests := []struct {
+			t string
+			v models.League
+		}{
+			{"A", models.American},
+			{"n", models.National},
+			{"F", models.Federal},
+			{"AA", models.AmericanAssociation},
+			{"UA", models.UnionAssociation},
+			{"PL", models.Players},
+			{"NNL", models.NegroNational},
+			{"NAL", models.NegroAmerican},
+			{"NA", models.NationalAssociation},
+			{"X", -1},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				convey.So(readers.ParseLeague(test.t), convey.ShouldEqual, test.v)
+			})
+		}
+	})
+}
+
+func TestParseHitLocation(t *testing.T) {
+	convey.Convey("Given hit locations...", t, func() {
+		tests := []struct {
+			t string
+			v models.HitLocation
+		}{
+			{
+				"F9LDF",
+				models.HitLocation{
+					Zone:      []models.Position{models.PositionRightField},
+					Direction: models.DirectionLeft,
+					Depth:     models.DepthDeep,
+					Foul:      true,
+				},
+			},
+			{
+				"F89XD",
+				models.HitLocation{
+					Zone:  []models.Position{models.PositionCenterField, models.PositionRightField},
+					Depth: models.DepthExtraDeep,
+				},
+			},
+			{
+				"G4MS",
+				models.HitLocation{
+					Zone:      []models.Position{models.PositionSecondBase},
+					Direction: models.DirectionMiddle,
+					Depth:     models.DepthShallow,
+				},
+			},
+			{
+				"P25F",
+				models.HitLocation{
+					Zone: []models.Position{models.PositionCatcher, models.PositionThirdBase},
+					Foul: true,
+				},
+			},
+			{
+				"G",
+				models.HitLocation{},
+			},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				ed, err := readers.ParseEventDetail("S7/" + test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ed.Modifiers[0].Location, convey.ShouldResemble, test.v)
+				convey.So(ed.Modifiers[0].Location.String(), convey.ShouldEqual, test.t[1:])
+			})
+		}
+	})
+}
+
+func TestParseModifierWithoutLocation(t *testing.T) {
+	convey.Convey("Given modifiers whose digits are not a hit location...", t, func() {
+		tests := []struct {
+			t string
+			v models.PlayModifier
+		}{
+			{"S7/TH2", models.ModifierThrowing},
+			{"E6/E5", models.ModifierErrorOn},
+			{"S7/R4", models.ModifierRelayThrow},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				ed, err := readers.ParseEventDetail(test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ed.Modifiers[0].PlayModifier, convey.ShouldEqual, test.v)
+				convey.So(ed.Modifiers[0].Location, convey.ShouldResemble, models.HitLocation{})
+			})
+		}
+		convey.Convey("Parse a bare location S8/78XD...", func() {
+			ed, err := readers.ParseEventDetail("S8/78XD")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ed.Modifiers[0].PlayModifier, convey.ShouldEqual, models.ModifierHitLocation)
+			convey.So(ed.Modifiers[0].Location.String(), convey.ShouldEqual, "78XD")
+		})
+	})
+}
+
+func TestPlayModifierMatching(t *testing.T) {
+	convey.Convey("Given modifier strings...", t, func() {
+		tests := []struct {
+			t string
+			v models.PlayModifier
+		}{
+			{"BGDP", models.ModifierGroundBallDoublePlayBunt},
+			{"BG25", models.ModifierGroundBallBunt},
+			{"GDP", models.ModifierGroundBallDoublePlay},
+			{"G6M", models.ModifierGroundBall},
+			{"FL", models.ModifierFoulBall},
+			{"FO", models.ModifierForceOut},
+			{"F78XD", models.ModifierFlyBall},
+			{"LTP", models.ModifierLinedIntoTriplePlay},
+			{"C", models.ModifierCalledThirdStrike},
+			{"COUB", models.ModifierCourtesyBatter},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				ed, err := readers.ParseEventDetail("63/" + test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(len(ed.Modifiers), convey.ShouldEqual, 1)
+				convey.So(ed.Modifiers[0].PlayModifier, convey.ShouldEqual, test.v)
+			})
+		}
+	})
+}
+
 func TestParseEventDetail(t *testing.T) {
 	convey.Convey("Given play details...", t, func() {
 		tests := []struct {
@@ -220,10 +518,15 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.GroundRuleDouble,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierLinedDrive,
-							Location:     "9LS",
+							Location: models.HitLocation{
+								Zone:      []models.Position{models.PositionRightField},
+								Direction: models.DirectionLeft,
+								Depth:     models.DepthShallow,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -245,16 +548,29 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionCenterField,
 						models.PositionSecondBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCenterField, Credit: models.CreditPutout},
+						{Position: models.PositionCenterField, Credit: models.CreditAssist},
+						{Position: models.PositionSecondBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierLinedIntoDoublePlay,
 						},
 						{
 							PlayModifier: models.ModifierLinedDrive,
-							Location:     "8",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionCenterField},
+							},
+						},
+					},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  2,
+							FinishBase: 2,
+							Out:        true,
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
 				},
 				nil,
 			},
@@ -267,16 +583,27 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionThirdBase,
 						models.PositionSecondBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionThirdBase, Credit: models.CreditAssist},
+						{Position: models.PositionSecondBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierForceOut,
 						},
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "5",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionThirdBase},
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  1,
+							FinishBase: 2,
+							Out:        true,
+						},
 						{
 							StartBase:  3,
 							FinishBase: 4,
@@ -298,12 +625,22 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionFirstBase,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierLinedIntoDoublePlay,
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  1,
+							FinishBase: 1,
+							Out:        true,
+						},
+					},
 				},
 				nil,
 			},
@@ -318,16 +655,30 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionSecondBase,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionShortStop, Credit: models.CreditAssist},
+						{Position: models.PositionSecondBase, Credit: models.CreditPutout},
+						{Position: models.PositionSecondBase, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBallDoublePlay,
 						},
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "6",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionShortStop},
+							},
+						},
+					},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  1,
+							FinishBase: 2,
+							Out:        true,
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
 				},
 				nil,
 			},
@@ -340,16 +691,29 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionSecondBase,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionSecondBase, Credit: models.CreditPutout},
+						{Position: models.PositionSecondBase, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "4",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionSecondBase},
+							},
 						},
 						{
 							PlayModifier: models.ModifierGroundBallDoublePlay,
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  1,
+							FinishBase: 2,
+							Out:        true,
+						},
+					},
 				},
 				nil,
 			},
@@ -365,16 +729,36 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionShortStop,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionPitcher, Credit: models.CreditPutout},
+						{Position: models.PositionPitcher, Credit: models.CreditAssist},
+						{Position: models.PositionShortStop, Credit: models.CreditPutout},
+						{Position: models.PositionShortStop, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierLinedIntoTriplePlay,
 						},
 						{
 							PlayModifier: models.ModifierLinedDrive,
-							Location:     "1",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionPitcher},
+							},
+						},
+					},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  2,
+							FinishBase: 2,
+							Out:        true,
+						},
+						{
+							StartBase:  1,
+							FinishBase: 1,
+							Out:        true,
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
 				},
 				nil,
 			},
@@ -386,10 +770,13 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionLeftField,
 					},
+					Credits: []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "5",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionThirdBase},
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -415,10 +802,14 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.HomeRun,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierFlyBall,
-							Location:     "78XD",
+							Location: models.HitLocation{
+								Zone:  []models.Position{models.PositionLeftField, models.PositionCenterField},
+								Depth: models.DepthExtraDeep,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -440,10 +831,15 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.HomeRun,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierFlyBall,
-							Location:     "9LS",
+							Location: models.HitLocation{
+								Zone:      []models.Position{models.PositionRightField},
+								Direction: models.DirectionLeft,
+								Depth:     models.DepthShallow,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -467,13 +863,29 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionThirdBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionThirdBase, Credit: models.CreditAssist},
+						{Position: models.PositionCatcher, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "5",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionThirdBase},
+							},
+						},
+					},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  3,
+							FinishBase: 4,
+							Out:        true,
+							Fielders: []models.Position{
+								models.PositionThirdBase,
+								models.PositionCatcher,
+							},
 						},
 					},
-					RunnerAdv: []models.RunnerAdvance{},
 				},
 				nil,
 			},
@@ -485,10 +897,15 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionCenterField,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCenterField, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierFlyBall,
-							Location:     "78",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionLeftField, models.PositionCenterField},
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{},
@@ -504,10 +921,16 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionThirdBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionThirdBase, Credit: models.CreditError},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierPopup,
-							Location:     "5F",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionThirdBase},
+								Foul: true,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{},
@@ -523,6 +946,9 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionFirstBase, Credit: models.CreditError},
+					},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -549,6 +975,10 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionCatcher,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -567,6 +997,7 @@ func TestParseEventDetail(t *testing.T) {
 						models.WildPitch,
 					},
 					Fielders:  []models.Position{},
+					Credits:   []models.FieldingCredit{},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -586,8 +1017,22 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionPitcher,
 						models.PositionCatcher,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionPitcher, Credit: models.CreditAssist},
+						{Position: models.PositionCatcher, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{},
-					RunnerAdv: []models.RunnerAdvance{},
+					RunnerAdv: []models.RunnerAdvance{
+						{
+							StartBase:  3,
+							FinishBase: 4,
+							Out:        true,
+							Fielders: []models.Position{
+								models.PositionPitcher,
+								models.PositionCatcher,
+							},
+						},
+					},
 				},
 				nil,
 			},
@@ -597,6 +1042,7 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.Balk,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers:  []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -618,6 +1064,7 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.IntentionalWalk,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers:  []models.Modifier{},
 					RunnerAdv:  []models.RunnerAdvance{},
 				},
@@ -629,6 +1076,7 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.Walk,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers:  []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -645,6 +1093,7 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.NoPlay,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers:  []models.Modifier{},
 					RunnerAdv:  []models.RunnerAdvance{},
 				},
@@ -658,6 +1107,7 @@ func TestParseEventDetail(t *testing.T) {
 						models.WildPitch,
 					},
 					Fielders:  []models.Position{},
+					Credits:   []models.FieldingCredit{},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -675,7 +1125,10 @@ func TestParseEventDetail(t *testing.T) {
 					ExtraPlays: []models.BasicPlay{
 						models.PassedBall,
 					},
-					Fielders:  []models.Position{},
+					Fielders: []models.Position{},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -695,6 +1148,10 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionCatcher,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{},
 				},
@@ -706,8 +1163,11 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.StrikeOut,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
-					Modifiers:  []models.Modifier{},
-					RunnerAdv:  []models.RunnerAdvance{},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditPutout},
+					},
+					Modifiers: []models.Modifier{},
+					RunnerAdv: []models.RunnerAdvance{},
 				},
 				nil,
 			},
@@ -717,6 +1177,7 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.HitByPitch,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers:  []models.Modifier{},
 					RunnerAdv: []models.RunnerAdvance{
 						{
@@ -734,10 +1195,14 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.HomeRun,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits:    []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierLinedDrive,
-							Location:     "7D",
+							Location: models.HitLocation{
+								Zone:  []models.Position{models.PositionLeftField},
+								Depth: models.DepthDeep,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{},
@@ -753,10 +1218,14 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "3S",
+							Location: models.HitLocation{
+								Zone:  []models.Position{models.PositionFirstBase},
+								Depth: models.DepthShallow,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -781,13 +1250,18 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionPitcher,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionPitcher, Credit: models.CreditError},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierThrowing,
 						},
 						{
 							PlayModifier: models.ModifierGroundBallBunt,
-							Location:     "15",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionPitcher, models.PositionThirdBase},
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -807,10 +1281,15 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionRightField,
 					},
+					Credits: []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierFlyBall,
-							Location:     "9LD",
+							Location: models.HitLocation{
+								Zone:      []models.Position{models.PositionRightField},
+								Direction: models.DirectionLeft,
+								Depth:     models.DepthDeep,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -831,6 +1310,7 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionRightField,
 					},
+					Credits: []models.FieldingCredit{},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
@@ -846,10 +1326,12 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.CatcherInterference,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionPitcher, Credit: models.CreditError},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierErrorOn,
-							Location:     "1",
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -867,10 +1349,12 @@ func TestParseEventDetail(t *testing.T) {
 					Play:       models.CatcherInterference,
 					ExtraPlays: []models.BasicPlay{},
 					Fielders:   []models.Position{},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditError},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierErrorOn,
-							Location:     "2",
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{
@@ -892,6 +1376,10 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionCatcher,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionCatcher, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierSacrificeBunt,
@@ -916,10 +1404,16 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionThirdBase,
 						models.PositionSecondBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionThirdBase, Credit: models.CreditAssist},
+						{Position: models.PositionSecondBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBallBunt,
-							Location:     "25",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionCatcher, models.PositionThirdBase},
+							},
 						},
 						{
 							PlayModifier: models.ModifierSacrificeBunt,
@@ -944,10 +1438,17 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionSecondBase,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionPitcher, Credit: models.CreditAssist},
+						{Position: models.PositionSecondBase, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "1",
+							Location: models.HitLocation{
+								Zone: []models.Position{models.PositionPitcher},
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{},
@@ -962,6 +1463,9 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionRightField,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionRightField, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierSacrificeFly,
@@ -985,10 +1489,17 @@ func TestParseEventDetail(t *testing.T) {
 						models.PositionShortStop,
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionShortStop, Credit: models.CreditAssist},
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
-							Location:     "6M",
+							Location: models.HitLocation{
+								Zone:      []models.Position{models.PositionShortStop},
+								Direction: models.DirectionMiddle,
+							},
 						},
 					},
 					RunnerAdv: []models.RunnerAdvance{},
@@ -1003,6 +1514,9 @@ func TestParseEventDetail(t *testing.T) {
 					Fielders: []models.Position{
 						models.PositionFirstBase,
 					},
+					Credits: []models.FieldingCredit{
+						{Position: models.PositionFirstBase, Credit: models.CreditPutout},
+					},
 					Modifiers: []models.Modifier{
 						{
 							PlayModifier: models.ModifierGroundBall,
@@ -1020,10 +1534,103 @@ func TestParseEventDetail(t *testing.T) {
 		}
 		for _, test := range tests {
 			convey.Convey("Parse "+test.t+"...", func() {
-				ed, ok := readers.ParseEventDetail(test.t)
-				convey.So(ok, convey.ShouldBeTrue)
+				ed, err := readers.ParseEventDetail(test.t)
+				convey.So(err, convey.ShouldEqual, test.err)
 				convey.So(ed, convey.ShouldResemble, test.v)
 			})
 		}
 	})
 }
+
+func TestParseBaserunning(t *testing.T) {
+	convey.Convey("Given baserunning plays...", t, func() {
+		tests := []struct {
+			t string
+			v []models.RunnerAdvance
+		}{
+			{
+				"SBH;SB3",
+				[]models.RunnerAdvance{
+					{StartBase: 3, FinishBase: 4},
+					{StartBase: 2, FinishBase: 3},
+				},
+			},
+			{
+				"CS2(2E4)",
+				[]models.RunnerAdvance{
+					{
+						StartBase:       1,
+						FinishBase:      2,
+						ErrorNegatedOut: true,
+						Fielders:        []models.Position{models.PositionCatcher, models.PositionSecondBase},
+						Errors:          []models.Position{models.PositionSecondBase},
+					},
+				},
+			},
+			{
+				"CS2(2E4).1-3",
+				[]models.RunnerAdvance{
+					{StartBase: 1, FinishBase: 3},
+				},
+			},
+			{
+				"PO1(13)",
+				[]models.RunnerAdvance{
+					{
+						StartBase:  1,
+						FinishBase: 1,
+						Out:        true,
+						Fielders:   []models.Position{models.PositionPitcher, models.PositionFirstBase},
+					},
+				},
+			},
+			{
+				"POCSH(1361)",
+				[]models.RunnerAdvance{
+					{
+						StartBase:  3,
+						FinishBase: 4,
+						Out:        true,
+						Fielders: []models.Position{
+							models.PositionPitcher,
+							models.PositionFirstBase,
+							models.PositionShortStop,
+							models.PositionPitcher,
+						},
+					},
+				},
+			},
+			{
+				"K+SB2",
+				[]models.RunnerAdvance{
+					{StartBase: 1, FinishBase: 2},
+				},
+			},
+			{
+				"K+CS2(26)/DP",
+				[]models.RunnerAdvance{
+					{
+						StartBase:  1,
+						FinishBase: 2,
+						Out:        true,
+						Fielders:   []models.Position{models.PositionCatcher, models.PositionShortStop},
+					},
+				},
+			},
+			{
+				"W+SB3;SB2",
+				[]models.RunnerAdvance{
+					{StartBase: 2, FinishBase: 3},
+					{StartBase: 1, FinishBase: 2},
+				},
+			},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				ed, err := readers.ParseEventDetail(test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ed.RunnerAdv, convey.ShouldResemble, test.v)
+			})
+		}
+	})
+}
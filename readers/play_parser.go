@@ -0,0 +1,344 @@
+package readers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The play field of a play record has the grammar
+//
+//	play      = events [ "+" events ] { "/" modifier } [ "." advances ]
+//	events    = event { ";" event }
+//	event     = WORD { WORD | group }
+//	modifier  = { WORD | "+" | "-" | group }
+//	advances  = advance { ";" advance }
+//	advance   = base ( "-" | "X" ) base { group }
+//	group     = "(" ... ")"
+//
+// The uncertainty and exceptional play markers (#, ! and ?) carry no meaning
+// for the parse and are dropped by the tokenizer.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenPlus
+	tokenMinus
+	tokenSlash
+	tokenDot
+	tokenSemi
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+// PlaySyntaxError reports the character offset where a play failed to parse.
+type PlaySyntaxError struct {
+	Play   string
+	Offset int
+	Reason string
+}
+
+func (e *PlaySyntaxError) Error() string {
+	return fmt.Sprintf("play %q: %s at offset %d", e.Play, e.Reason, e.Offset)
+}
+
+// EventNode is one event code such as 54(1), K23 or PO2(E1/TH). Code holds
+// the event with its groups, Groups the contents of each group.
+type EventNode struct {
+	Code   string
+	Groups []string
+	Offset int
+}
+
+// ModifierNode is a single / separated modifier such as G5, TH or L9LS.
+type ModifierNode struct {
+	Code   string
+	Offset int
+}
+
+// AdvanceNode is a single runner advance such as 2XH(52) or B-1.
+type AdvanceNode struct {
+	Code    string
+	Start   string
+	Finish  string
+	Out     bool
+	Details []string
+	Offset  int
+}
+
+// PlayNode is the parsed form of a play field. Primary holds the events
+// before any +, usually one but several for plays like SBH;SB3, and
+// Secondary the events after it, e.g. SB2 in K+SB2.
+type PlayNode struct {
+	Primary   []EventNode
+	Secondary []EventNode
+	Modifiers []ModifierNode
+	Advances  []AdvanceNode
+}
+
+func tokenize(val string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(val); {
+		c := val[i]
+		switch {
+		case isWordChar(c):
+			start := i
+			for i < len(val) && isWordChar(val[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokenWord, val[start:i], start})
+			continue
+		case c == '+':
+			tokens = append(tokens, token{tokenPlus, "+", i})
+		case c == '-':
+			tokens = append(tokens, token{tokenMinus, "-", i})
+		case c == '/':
+			tokens = append(tokens, token{tokenSlash, "/", i})
+		case c == '.':
+			tokens = append(tokens, token{tokenDot, ".", i})
+		case c == ';':
+			tokens = append(tokens, token{tokenSemi, ";", i})
+		case c == '(':
+			tokens = append(tokens, token{tokenLParen, "(", i})
+		case c == ')':
+			tokens = append(tokens, token{tokenRParen, ")", i})
+		case c == '#' || c == '!' || c == '?' || c == ' ':
+		default:
+			return nil, &PlaySyntaxError{val, i, fmt.Sprintf("unexpected character %q", c)}
+		}
+		i++
+	}
+	tokens = append(tokens, token{tokenEOF, "", len(val)})
+	return tokens, nil
+}
+
+func isWordChar(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
+
+type playParser struct {
+	play   string
+	tokens []token
+	pos    int
+}
+
+func newPlayParser(val string) (*playParser, error) {
+	tokens, err := tokenize(val)
+	if err != nil {
+		return nil, err
+	}
+	return &playParser{play: val, tokens: tokens}, nil
+}
+
+func (p *playParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *playParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *playParser) errorf(offset int, format string, args ...interface{}) error {
+	return &PlaySyntaxError{p.play, offset, fmt.Sprintf(format, args...)}
+}
+
+// ParsePlay parses the play field of a play record into a PlayNode.
+func ParsePlay(val string) (PlayNode, error) {
+	node := PlayNode{
+		Secondary: []EventNode{},
+		Modifiers: []ModifierNode{},
+		Advances:  []AdvanceNode{},
+	}
+	p, err := newPlayParser(val)
+	if err != nil {
+		return node, err
+	}
+
+	node.Primary, err = p.parseEvents()
+	if err != nil {
+		return node, err
+	}
+	for p.peek().kind == tokenPlus {
+		p.next()
+		events, err := p.parseEvents()
+		if err != nil {
+			return node, err
+		}
+		node.Secondary = append(node.Secondary, events...)
+	}
+	for p.peek().kind == tokenSlash {
+		p.next()
+		mod, err := p.parseModifier()
+		if err != nil {
+			return node, err
+		}
+		if mod.Code != "" {
+			node.Modifiers = append(node.Modifiers, mod)
+		}
+	}
+	if p.peek().kind == tokenDot {
+		p.next()
+		node.Advances, err = p.parseAdvances()
+		if err != nil {
+			return node, err
+		}
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return node, p.errorf(t.offset, "unexpected %q", t.text)
+	}
+	return node, nil
+}
+
+func (p *playParser) parseEvents() ([]EventNode, error) {
+	events := []EventNode{}
+	for {
+		ev, err := p.parseEvent()
+		if err != nil {
+			return events, err
+		}
+		events = append(events, ev)
+		if p.peek().kind != tokenSemi {
+			return events, nil
+		}
+		p.next()
+	}
+}
+
+func (p *playParser) parseEvent() (EventNode, error) {
+	t := p.peek()
+	ev := EventNode{Offset: t.offset}
+	if t.kind != tokenWord {
+		return ev, p.errorf(t.offset, "expected event, found %q", t.text)
+	}
+	var code strings.Builder
+	for {
+		switch p.peek().kind {
+		case tokenWord:
+			code.WriteString(p.next().text)
+		case tokenLParen:
+			group, err := p.parseGroup()
+			if err != nil {
+				return ev, err
+			}
+			code.WriteString("(" + group + ")")
+			ev.Groups = append(ev.Groups, group)
+		default:
+			ev.Code = code.String()
+			return ev, nil
+		}
+	}
+}
+
+func (p *playParser) parseModifier() (ModifierNode, error) {
+	mod := ModifierNode{Offset: p.peek().offset}
+	var code strings.Builder
+	for {
+		switch p.peek().kind {
+		case tokenWord, tokenPlus, tokenMinus:
+			code.WriteString(p.next().text)
+		case tokenLParen:
+			group, err := p.parseGroup()
+			if err != nil {
+				return mod, err
+			}
+			code.WriteString("(" + group + ")")
+		case tokenSlash, tokenDot, tokenEOF:
+			mod.Code = code.String()
+			return mod, nil
+		default:
+			t := p.peek()
+			return mod, p.errorf(t.offset, "unexpected %q in modifier", t.text)
+		}
+	}
+}
+
+func (p *playParser) parseAdvances() ([]AdvanceNode, error) {
+	advances := []AdvanceNode{}
+	for {
+		adv, err := p.parseAdvance()
+		if err != nil {
+			return advances, err
+		}
+		advances = append(advances, adv)
+		if p.peek().kind != tokenSemi {
+			return advances, nil
+		}
+		p.next()
+	}
+}
+
+func (p *playParser) parseAdvance() (AdvanceNode, error) {
+	t := p.next()
+	adv := AdvanceNode{Offset: t.offset}
+	if t.kind != tokenWord {
+		return adv, p.errorf(t.offset, "expected runner, found %q", t.text)
+	}
+	switch {
+	case len(t.text) == 3 && t.text[1] == 'X':
+		adv.Start, adv.Finish, adv.Out = t.text[:1], t.text[2:], true
+	case len(t.text) == 1 && p.peek().kind == tokenMinus:
+		p.next()
+		f := p.next()
+		if f.kind != tokenWord || len(f.text) != 1 {
+			return adv, p.errorf(f.offset, "expected base, found %q", f.text)
+		}
+		adv.Start, adv.Finish = t.text, f.text
+	default:
+		return adv, p.errorf(t.offset, "malformed runner advance %q", t.text)
+	}
+	if !strings.Contains("B123", adv.Start) {
+		return adv, p.errorf(t.offset, "unknown start base %q", adv.Start)
+	}
+	if !strings.Contains("123H", adv.Finish) {
+		return adv, p.errorf(t.offset, "unknown finish base %q", adv.Finish)
+	}
+
+	sep := "-"
+	if adv.Out {
+		sep = "X"
+	}
+	code := adv.Start + sep + adv.Finish
+	for p.peek().kind == tokenLParen {
+		group, err := p.parseGroup()
+		if err != nil {
+			return adv, err
+		}
+		code += "(" + group + ")"
+		adv.Details = append(adv.Details, group)
+	}
+	adv.Code = code
+	return adv, nil
+}
+
+// parseGroup consumes a parenthesized group, nested groups included, and
+// returns the text between the outer parentheses.
+func (p *playParser) parseGroup() (string, error) {
+	open := p.next()
+	depth := 1
+	for depth > 0 {
+		t := p.next()
+		switch t.kind {
+		case tokenLParen:
+			depth++
+		case tokenRParen:
+			depth--
+			if depth == 0 {
+				return p.play[open.offset+1 : t.offset], nil
+			}
+		case tokenEOF:
+			return "", p.errorf(open.offset, "unclosed (")
+		}
+	}
+	return "", nil
+}
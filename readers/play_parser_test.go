@@ -0,0 +1,177 @@
+package readers_test
+
+import (
+	"testing"
+
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+func TestParsePlay(t *testing.T) {
+	convey.Convey("Given play strings...", t, func() {
+		tests := []struct {
+			t string
+			v readers.PlayNode
+		}{
+			{
+				"K+SB2",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "K", Offset: 0},
+					},
+					Secondary: []readers.EventNode{
+						{Code: "SB2", Offset: 2},
+					},
+					Modifiers: []readers.ModifierNode{},
+					Advances:  []readers.AdvanceNode{},
+				},
+			},
+			{
+				"SBH;SB3",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "SBH", Offset: 0},
+						{Code: "SB3", Offset: 4},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{},
+					Advances:  []readers.AdvanceNode{},
+				},
+			},
+			{
+				"54(1)/FO/G5.3-H;B-1",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "54(1)", Groups: []string{"1"}, Offset: 0},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{
+						{Code: "FO", Offset: 6},
+						{Code: "G5", Offset: 9},
+					},
+					Advances: []readers.AdvanceNode{
+						{Code: "3-H", Start: "3", Finish: "H", Offset: 12},
+						{Code: "B-1", Start: "B", Finish: "1", Offset: 16},
+					},
+				},
+			},
+			{
+				"FC5/G.3XH(52)",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "FC5", Offset: 0},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{
+						{Code: "G", Offset: 4},
+					},
+					Advances: []readers.AdvanceNode{
+						{Code: "3XH(52)", Start: "3", Finish: "H", Out: true, Details: []string{"52"}, Offset: 6},
+					},
+				},
+			},
+			{
+				"E1/TH/BG15.1-3",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "E1", Offset: 0},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{
+						{Code: "TH", Offset: 3},
+						{Code: "BG15", Offset: 6},
+					},
+					Advances: []readers.AdvanceNode{
+						{Code: "1-3", Start: "1", Finish: "3", Offset: 11},
+					},
+				},
+			},
+			{
+				"PO2(E1/TH).2-H(UR)",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "PO2(E1/TH)", Groups: []string{"E1/TH"}, Offset: 0},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{},
+					Advances: []readers.AdvanceNode{
+						{Code: "2-H(UR)", Start: "2", Finish: "H", Details: []string{"UR"}, Offset: 11},
+					},
+				},
+			},
+			{
+				"S8/G+.2-H(E8/TH.2)#",
+				readers.PlayNode{
+					Primary: []readers.EventNode{
+						{Code: "S8", Offset: 0},
+					},
+					Secondary: []readers.EventNode{},
+					Modifiers: []readers.ModifierNode{
+						{Code: "G+", Offset: 3},
+					},
+					Advances: []readers.AdvanceNode{
+						{Code: "2-H(E8/TH.2)", Start: "2", Finish: "H", Details: []string{"E8/TH.2"}, Offset: 6},
+					},
+				},
+			},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				node, err := readers.ParsePlay(test.t)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(node, convey.ShouldResemble, test.v)
+			})
+		}
+	})
+}
+
+func TestParsePlayErrors(t *testing.T) {
+	convey.Convey("Given malformed play strings...", t, func() {
+		tests := []struct {
+			t      string
+			offset int
+		}{
+			{"", 0},
+			{"S8$", 2},
+			{"S8.4-H", 3},
+			{"S8.2-", 5},
+			{"S8.2-H(E8", 6},
+			{"63/G.1-2;", 9},
+			{"S8)", 2},
+		}
+		for _, test := range tests {
+			convey.Convey("Parse "+test.t+"...", func() {
+				_, err := readers.ParsePlay(test.t)
+				convey.So(err, convey.ShouldNotBeNil)
+				syntaxErr, ok := err.(*readers.PlaySyntaxError)
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(syntaxErr.Offset, convey.ShouldEqual, test.offset)
+			})
+		}
+	})
+}
+
+func TestParseCompoundEventDetail(t *testing.T) {
+	convey.Convey("Given compound plays...", t, func() {
+		convey.Convey("Parse K+SB2...", func() {
+			ed, err := readers.ParseEventDetail("K+SB2")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ed.Play, convey.ShouldEqual, models.StrikeOut)
+			convey.So(ed.ExtraPlays, convey.ShouldResemble, []models.BasicPlay{models.StolenBase})
+		})
+		convey.Convey("Parse PO2(E1/TH).2-H(UR)...", func() {
+			ed, err := readers.ParseEventDetail("PO2(E1/TH).2-H(UR)")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ed.Play, convey.ShouldEqual, models.PickOff)
+			convey.So(ed.Modifiers, convey.ShouldResemble, []models.Modifier{})
+			convey.So(ed.RunnerAdv, convey.ShouldResemble, []models.RunnerAdvance{
+				{
+					StartBase:  2,
+					FinishBase: 4,
+					Unearned:   true,
+				},
+			})
+		})
+	})
+}
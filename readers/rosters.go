@@ -4,16 +4,20 @@ import (
 	"archive/zip"
 	"encoding/csv"
 	"fmt"
-	"log"
+	"io"
 	"path/filepath"
 
+	"github.com/gocraft/dbr"
 	"github.com/wazupwiddat/retrosheet/models"
 )
 
 const RosterFileExt = ".ROS"
 
-func ReadPlayers(r *zip.ReadCloser) []models.Player {
+// ReadPlayers reads every roster file in the archive. Records that fail to
+// parse are skipped and returned as ParseErrors.
+func ReadPlayers(r *zip.ReadCloser) ([]models.Player, error) {
 	players := []models.Player{}
+	errs := ParseErrors{}
 	for _, f := range r.File {
 		if filepath.Ext(f.Name) != RosterFileExt {
 			continue
@@ -21,18 +25,26 @@ func ReadPlayers(r *zip.ReadCloser) []models.Player {
 		fmt.Printf("Reading %s:\n", f.Name)
 		rc, err := f.Open()
 		if err != nil {
-			log.Fatal(err, f.Name)
+			return players, err
 		}
 
 		reader := csv.NewReader(rc)
-		records, err := reader.ReadAll()
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		for _, r := range records {
+		reader.FieldsPerRecord = -1
+		for {
+			r, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil && !isRecordError(err) {
+				rc.Close()
+				return players, err
+			}
+			line := csvLine(reader, err)
+			if err != nil {
+				errs = append(errs, newParseError(f.Name, line, "roster", r, -1, err.Error()))
+				continue
+			}
 			if len(r) == 0 {
-				fmt.Println("Empty record")
 				continue
 			}
 			player := models.Player{}
@@ -50,9 +62,117 @@ func ReadPlayers(r *zip.ReadCloser) []models.Player {
 					player.Throws = ParseHanded(f)
 				}
 			}
+			if player.Bats < 0 {
+				errs = append(errs, newParseError(f.Name, line, "roster", r, 3, "unknown handedness"))
+				continue
+			}
+			if player.Throws < 0 {
+				errs = append(errs, newParseError(f.Name, line, "roster", r, 4, "unknown handedness"))
+				continue
+			}
 			players = append(players, player)
 		}
 		rc.Close()
 	}
-	return players
+	return players, errs.err()
+}
+
+// ReadRosters reads every roster file in the archive into roster entries,
+// <player>,<last name>,<first name>,<bats>,<throws>,<team>,<position>. The
+// players and teams must already be loaded. Records that fail to parse are
+// skipped and returned as ParseErrors.
+func ReadRosters(sess *dbr.Session, r *zip.ReadCloser) ([]models.RosterEntry, error) {
+	entries := []models.RosterEntry{}
+	errs := ParseErrors{}
+	for _, f := range r.File {
+		if filepath.Ext(f.Name) != RosterFileExt {
+			continue
+		}
+		fmt.Printf("Reading %s:\n", f.Name)
+		year, err := ParseYear(f.Name)
+		if err != nil {
+			errs = append(errs, newParseError(f.Name, 0, "roster", nil, -1, err.Error()))
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return entries, err
+		}
+
+		e, err := ReadRosterFromFile(sess, f.Name, year, rc)
+		rc.Close()
+		entries = append(entries, e...)
+		if pe, ok := err.(ParseErrors); ok {
+			errs = append(errs, pe...)
+		} else if err != nil {
+			return entries, err
+		}
+	}
+	return entries, errs.err()
+}
+
+// ReadRosterFromFile reads a single roster file for year; name is only used
+// to report errors.
+func ReadRosterFromFile(sess *dbr.Session, name string, year int, file io.Reader) ([]models.RosterEntry, error) {
+	entries := []models.RosterEntry{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	for {
+		r, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return entries, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(name, line, "roster", r, -1, err.Error()))
+			continue
+		}
+		if len(r) == 0 {
+			continue
+		}
+		if len(r) < 7 {
+			errs = append(errs, newParseError(name, line, "roster", r, -1, "too few fields"))
+			continue
+		}
+		entry := models.RosterEntry{
+			Year:     year,
+			Bats:     ParseHanded(r[3]),
+			Throws:   ParseHanded(r[4]),
+			Position: r[6],
+		}
+		if entry.Bats < 0 {
+			errs = append(errs, newParseError(name, line, "roster", r, 3, "unknown handedness"))
+			continue
+		}
+		if entry.Throws < 0 {
+			errs = append(errs, newParseError(name, line, "roster", r, 4, "unknown handedness"))
+			continue
+		}
+		player, err := models.GetPlayer(sess, r[0])
+		if err != nil {
+			errs = append(errs, newParseError(name, line, "roster", r, 0, err.Error()))
+			continue
+		}
+		if player.ID == 0 {
+			errs = append(errs, newParseError(name, line, "roster", r, 0, "player not found"))
+			continue
+		}
+		team, err := models.GetTeam(sess, r[5], year)
+		if err != nil {
+			errs = append(errs, newParseError(name, line, "roster", r, 5, err.Error()))
+			continue
+		}
+		if team.ID == 0 {
+			errs = append(errs, newParseError(name, line, "roster", r, 5, "team not found"))
+			continue
+		}
+		entry.Player = player.ID
+		entry.Team = team.ID
+		entries = append(entries, entry)
+	}
+	return entries, errs.err()
 }
@@ -0,0 +1,88 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const rosterFile = `ohtas001,Ohtani,Shohei,L,R,ANA,P
+simma001,Simmons,Andrelton,R,R,ANA,SS
+troum001,Trout,Mike,R,R,ANA
+`
+
+func TestReadRoster(t *testing.T) {
+	convey.Convey("Given a roster file ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		for _, p := range []string{"ohtas001", "simma001"} {
+			mock.ExpectQuery("SELECT (.+) FROM players").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, p))
+			mock.ExpectQuery("SELECT (.+) FROM teams").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}).AddRow(1, "ANA"))
+		}
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		entries, err := readers.ReadRosterFromFile(sess, "ANA2018.ROS", 2018, strings.NewReader(rosterFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		convey.So(err.(readers.ParseErrors)[0].Line, convey.ShouldEqual, 3)
+
+		convey.So(len(entries), convey.ShouldEqual, 2)
+		convey.So(entries[0].Year, convey.ShouldEqual, 2018)
+		convey.So(entries[0].Bats, convey.ShouldEqual, models.LeftHanded)
+		convey.So(entries[0].Throws, convey.ShouldEqual, models.RightHanded)
+		convey.So(entries[0].Position, convey.ShouldEqual, "P")
+		convey.So(entries[1].Position, convey.ShouldEqual, "SS")
+	})
+}
+
+const unknownRosterFile = `zzzzz001,Nobody,Known,R,R,ANA,P
+ohtas001,Ohtani,Shohei,L,R,XXX,P
+`
+
+func TestReadRosterUnknown(t *testing.T) {
+	convey.Convey("Given a roster file with an unknown player and team ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}))
+		mock.ExpectQuery("SELECT (.+) FROM players").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "player_id"}).AddRow(1, "ohtas001"))
+		mock.ExpectQuery("SELECT (.+) FROM teams").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		entries, err := readers.ReadRosterFromFile(sess, "ANA2018.ROS", 2018, strings.NewReader(unknownRosterFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		errs := err.(readers.ParseErrors)
+		convey.So(len(errs), convey.ShouldEqual, 2)
+		convey.So(errs[0].Field, convey.ShouldEqual, 0)
+		convey.So(errs[0].Reason, convey.ShouldEqual, "player not found")
+		convey.So(errs[1].Field, convey.ShouldEqual, 5)
+		convey.So(errs[1].Reason, convey.ShouldEqual, "team not found")
+		convey.So(len(entries), convey.ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,151 @@
+package readers
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gocraft/dbr"
+	"github.com/wazupwiddat/retrosheet/models"
+)
+
+// scheduleDayNightMap also takes the afternoon and evening codes used by
+// some schedules.
+var scheduleDayNightMap = map[string]models.DayNight{
+	"":  models.DayNightUnknown,
+	"d": models.Day,
+	"a": models.Day,
+	"n": models.Night,
+	"e": models.Night,
+}
+
+// IsScheduleFile reports whether name is a schedule file, yyyySKED.TXT or
+// yyyySKED.CSV.
+func IsScheduleFile(name string) bool {
+	base := strings.ToUpper(filepath.Base(name))
+	ext := filepath.Ext(base)
+	return strings.Contains(base, "SKED") && (ext == ".TXT" || ext == ".CSV")
+}
+
+// ReadSchedules reads every schedule file in the archive. Records that fail
+// to parse are skipped and returned as ParseErrors.
+func ReadSchedules(sess *dbr.Session, r *zip.ReadCloser) ([]models.ScheduledGame, error) {
+	schedule := []models.ScheduledGame{}
+	errs := ParseErrors{}
+	for _, f := range r.File {
+		if !IsScheduleFile(f.Name) {
+			continue
+		}
+		fmt.Printf("Reading %s:\n", f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			return schedule, err
+		}
+
+		s, err := ReadScheduleFromFile(sess, f.Name, rc)
+		rc.Close()
+		schedule = append(schedule, s...)
+		if pe, ok := err.(ParseErrors); ok {
+			errs = append(errs, pe...)
+		} else if err != nil {
+			return schedule, err
+		}
+	}
+	return schedule, errs.err()
+}
+
+// ReadScheduleFromFile reads a single schedule file,
+// <date>,<game number>,<day>,<visitor>,<league>,<game>,<home>,<league>,<game>,<day/night>,<postponement>,<makeup>;
+// name is only used to report errors.
+func ReadScheduleFromFile(sess *dbr.Session, name string, file io.Reader) ([]models.ScheduledGame, error) {
+	schedule := []models.ScheduledGame{}
+	errs := ParseErrors{}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && !isRecordError(err) {
+			return schedule, err
+		}
+		line := csvLine(reader, err)
+		if err != nil {
+			errs = append(errs, newParseError(name, line, "schedule", record, -1, err.Error()))
+			continue
+		}
+		s, field, reason := parseScheduledGame(sess, record)
+		if reason != "" {
+			errs = append(errs, newParseError(name, line, "schedule", record, field, reason))
+			continue
+		}
+		schedule = append(schedule, s)
+	}
+	return schedule, errs.err()
+}
+
+// parseScheduledGame turns a schedule record into a ScheduledGame. On
+// failure it returns the offending field and the reason.
+func parseScheduledGame(sess *dbr.Session, record []string) (models.ScheduledGame, int, string) {
+	if len(record) < 12 {
+		return models.ScheduledGame{}, -1, "too few fields"
+	}
+	s := models.ScheduledGame{
+		DayOfWeek:    record[2],
+		Postponement: strings.TrimSpace(record[10]),
+		MakeupInfo:   strings.TrimSpace(record[11]),
+	}
+
+	var err error
+	s.Scheduled, err = time.Parse("20060102", record[0])
+	if err != nil {
+		return s, 0, err.Error()
+	}
+	var ok bool
+	if s.GameNumber, ok = gameLogNumberMap[record[1]]; !ok {
+		return s, 1, "unknown game number"
+	}
+	if s.DayNight, ok = scheduleDayNightMap[strings.ToLower(record[9])]; !ok {
+		return s, 9, "unknown day or night"
+	}
+	if field, reason := parseStats(record, 5, &s.VisitorGameNumber); reason != "" {
+		return s, field, reason
+	}
+	if field, reason := parseStats(record, 8, &s.HomeGameNumber); reason != "" {
+		return s, field, reason
+	}
+
+	year := s.Scheduled.Year()
+	visitor, err := models.GetTeam(sess, record[3], year)
+	if err != nil {
+		return s, 3, err.Error()
+	}
+	if visitor.ID == 0 {
+		return s, 3, "team not found"
+	}
+	s.Visitor = visitor.ID
+	home, err := models.GetTeam(sess, record[6], year)
+	if err != nil {
+		return s, 6, err.Error()
+	}
+	if home.ID == 0 {
+		return s, 6, "team not found"
+	}
+	s.Home = home.ID
+
+	// the makeup field lists every date a game that was postponed again was
+	// moved to, the last being the one it was played on, or only explains
+	// why there was no makeup
+	for _, val := range strings.Split(s.MakeupInfo, ";") {
+		makeup, err := time.Parse("20060102", strings.TrimSpace(val))
+		if err == nil {
+			s.Makeup.Time, s.Makeup.Valid = makeup, true
+		}
+	}
+	return s, 0, ""
+}
@@ -0,0 +1,68 @@
+package readers_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	convey "github.com/smartystreets/goconvey/convey"
+	"github.com/wazupwiddat/retrosheet/models"
+	"github.com/wazupwiddat/retrosheet/readers"
+)
+
+const scheduleFile = `"20180329","0","Thu","COL","NL",1,"ARI","NL",1,"d","",""
+"20180414","0","Sat","STL","NL","x","CHN","NL",11,"d","",""
+"20180413","0","Fri","STL","NL",10,"CHN","NL",10,"a","Rain","20180501;20180628"
+"20180415","0","Sun","STL","NL",12,"XXX","NL",12,"d","",""
+`
+
+func TestReadSchedule(t *testing.T) {
+	convey.Convey("Given a schedule file ...", t, func() {
+		db, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer db.Close()
+
+		for _, team := range []string{"COL", "ARI", "STL", "CHN"} {
+			mock.ExpectQuery("SELECT (.+) FROM teams").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}).AddRow(1, team))
+		}
+		mock.ExpectQuery("SELECT (.+) FROM teams").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}).AddRow(1, "STL"))
+		mock.ExpectQuery("SELECT (.+) FROM teams").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "team_code"}))
+
+		conn := &dbr.Connection{
+			DB:            db,
+			EventReceiver: &dbr.NullEventReceiver{},
+			Dialect:       dialect.MySQL,
+		}
+		sess := conn.NewSession(nil)
+
+		schedule, err := readers.ReadScheduleFromFile(sess, "2018SKED.TXT", strings.NewReader(scheduleFile))
+		convey.So(err, convey.ShouldHaveSameTypeAs, readers.ParseErrors{})
+		pe := err.(readers.ParseErrors)
+		convey.So(len(pe), convey.ShouldEqual, 2)
+		convey.So(pe[0].Line, convey.ShouldEqual, 2)
+		convey.So(pe[0].Field, convey.ShouldEqual, 5)
+		convey.So(pe[1].Line, convey.ShouldEqual, 4)
+		convey.So(pe[1].Field, convey.ShouldEqual, 6)
+		convey.So(pe[1].Reason, convey.ShouldEqual, "team not found")
+
+		convey.So(len(schedule), convey.ShouldEqual, 2)
+		convey.So(schedule[0].DayNight, convey.ShouldEqual, models.Day)
+		convey.So(schedule[0].Postponed(), convey.ShouldBeFalse)
+		convey.So(schedule[0].Makeup.Valid, convey.ShouldBeFalse)
+
+		s := schedule[1]
+		convey.So(s.Scheduled, convey.ShouldEqual, time.Date(2018, 4, 13, 0, 0, 0, 0, time.UTC))
+		convey.So(s.DayOfWeek, convey.ShouldEqual, "Fri")
+		convey.So(s.VisitorGameNumber, convey.ShouldEqual, 10)
+		convey.So(s.Postponed(), convey.ShouldBeTrue)
+		convey.So(s.Postponement, convey.ShouldEqual, "Rain")
+		convey.So(s.Makeup.Time, convey.ShouldEqual, time.Date(2018, 6, 28, 0, 0, 0, 0, time.UTC))
+	})
+}
@@ -4,7 +4,7 @@ import (
 	"archive/zip"
 	"encoding/csv"
 	"fmt"
-	"log"
+	"io"
 	"strings"
 
 	"github.com/wazupwiddat/retrosheet/models"
@@ -12,28 +12,43 @@ import (
 
 const FileMatcher = "TEAM"
 
-func ReadTeams(r *zip.ReadCloser) []models.Team {
+// ReadTeams reads every TEAMyyyy file in the archive. Records that fail to
+// parse are skipped and returned as ParseErrors.
+func ReadTeams(r *zip.ReadCloser) ([]models.Team, error) {
 	teams := []models.Team{}
+	errs := ParseErrors{}
 	for _, f := range r.File {
 		if !strings.Contains(f.Name, FileMatcher) {
 			continue
 		}
 		fmt.Printf("Reading %s:\n", f.Name)
-		rc, err := f.Open()
+		year, err := ParseYear(f.Name)
 		if err != nil {
-			log.Fatal(err, f.Name)
+			errs = append(errs, newParseError(f.Name, 0, "team", nil, -1, err.Error()))
+			continue
 		}
-
-		reader := csv.NewReader(rc)
-		records, err := reader.ReadAll()
+		rc, err := f.Open()
 		if err != nil {
-			log.Fatal(err)
+			return teams, err
 		}
 
-		year := ParseYear(f.Name)
-		for _, r := range records {
+		reader := csv.NewReader(rc)
+		reader.FieldsPerRecord = -1
+		for {
+			r, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil && !isRecordError(err) {
+				rc.Close()
+				return teams, err
+			}
+			line := csvLine(reader, err)
+			if err != nil {
+				errs = append(errs, newParseError(f.Name, line, "team", r, -1, err.Error()))
+				continue
+			}
 			if len(r) == 0 {
-				fmt.Println("Empty record")
 				continue
 			}
 			team := models.Team{
@@ -51,9 +66,13 @@ func ReadTeams(r *zip.ReadCloser) []models.Team {
 					team.Mascot = f
 				}
 			}
+			if team.League < 0 {
+				errs = append(errs, newParseError(f.Name, line, "team", r, 1, "unknown league"))
+				continue
+			}
 			teams = append(teams, team)
 		}
 		rc.Close()
 	}
-	return teams
+	return teams, errs.err()
 }